@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/vechain/thor/abi"
 	"github.com/vechain/thor/builtin/gen"
@@ -84,3 +85,105 @@ func TestABI(t *testing.T) {
 
 	}
 }
+
+func TestUnpackRevert(t *testing.T) {
+	data := common.FromHex("0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000003" +
+		"666f6f0000000000000000000000000000000000000000000000000000000000")
+	reason, err := abi.UnpackRevert(data)
+	assert.Nil(t, err)
+	assert.Equal(t, "foo", reason)
+
+	reason, err = abi.UnpackRevert(common.FromHex("0x4e487b71" +
+		"0000000000000000000000000000000000000000000000000000000000000011"))
+	assert.Nil(t, err)
+	assert.Equal(t, "panic: arithmetic underflow or overflow (0x11)", reason)
+
+	reason, err = abi.UnpackRevert(common.FromHex("0x4e487b71" +
+		"00000000000000000000000000000000000000000000000000000000000000ff"))
+	assert.Nil(t, err)
+	assert.Equal(t, "panic: unknown code (0xff)", reason)
+
+	_, err = abi.UnpackRevert(common.FromHex("0x12345678"))
+	assert.NotNil(t, err)
+}
+
+func TestEventArgs(t *testing.T) {
+	abiJSON := `[{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"},{"indexed":false,"name":"delta","type":"int256"}],"name":"Transfer","type":"event"}]`
+	contract, err := abi.New([]byte(abiJSON))
+	assert.Nil(t, err)
+	event, found := contract.EventByName("Transfer")
+	assert.True(t, found)
+	assert.False(t, event.Anonymous())
+
+	from := thor.BytesToAddress([]byte("from"))
+	to := thor.BytesToAddress([]byte("to"))
+
+	i, fromTopic, err := event.EncodeTopic("from", from.String())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, i)
+	assert.Equal(t, thor.BytesToBytes32(from.Bytes()), fromTopic)
+
+	i, toTopic, err := event.EncodeTopic("to", to.String())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, i)
+
+	_, _, err = event.EncodeTopic("value", "1")
+	assert.NotNil(t, err, "not indexed")
+
+	data, err := event.Encode(big.NewInt(100), big.NewInt(-1))
+	assert.Nil(t, err)
+
+	args, err := event.DecodeArgs([]thor.Bytes32{event.ID(), fromTopic, toTopic}, data)
+	assert.Nil(t, err)
+	assert.Equal(t, common.Address(from), args["from"])
+	assert.Equal(t, common.Address(to), args["to"])
+	assert.Equal(t, big.NewInt(100), args["value"])
+	assert.Equal(t, big.NewInt(-1), args["delta"])
+
+	_, err = event.DecodeArgs([]thor.Bytes32{event.ID(), fromTopic}, data)
+	assert.NotNil(t, err, "insufficient topics")
+}
+
+func TestEncodeTopic(t *testing.T) {
+	abiJSON := `[{"anonymous":true,"inputs":[{"indexed":true,"name":"u8","type":"uint8"},{"indexed":true,"name":"i16","type":"int16"},{"indexed":true,"name":"b","type":"bool"},{"indexed":true,"name":"s","type":"string"}],"name":"E","type":"event"}]`
+	contract, err := abi.New([]byte(abiJSON))
+	assert.Nil(t, err)
+	event, _ := contract.EventByName("E")
+	assert.True(t, event.Anonymous())
+
+	i, topic, err := event.EncodeTopic("u8", "0xff")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, i)
+	assert.Equal(t, thor.BytesToBytes32([]byte{0xff}), topic)
+
+	_, _, err = event.EncodeTopic("u8", "256")
+	assert.NotNil(t, err, "out of range")
+
+	i, topic, err = event.EncodeTopic("i16", "-1")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, i)
+	assert.Equal(t, thor.MustParseBytes32("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"), topic)
+
+	_, _, err = event.EncodeTopic("i16", "32768")
+	assert.NotNil(t, err, "out of range")
+
+	_, topic, err = event.EncodeTopic("b", "true")
+	assert.Nil(t, err)
+	assert.Equal(t, thor.BytesToBytes32([]byte{1}), topic)
+
+	i, topic, err = event.EncodeTopic("s", "foo")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, i)
+	assert.Equal(t, thor.Bytes32(crypto.Keccak256Hash([]byte("foo"))), topic)
+
+	args, err := event.DecodeArgs([]thor.Bytes32{thor.BytesToBytes32([]byte{0xff}), thor.MustParseBytes32("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"), thor.BytesToBytes32([]byte{1}), topic}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"u8":  uint8(0xff),
+		"i16": int16(-1),
+		"b":   true,
+		"s":   topic,
+	}, args)
+}
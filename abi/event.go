@@ -6,7 +6,14 @@
 package abi
 
 import (
+	"errors"
+	"math/big"
+	"strconv"
+
 	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/vechain/thor/thor"
 )
 
@@ -50,3 +57,152 @@ func (e *Event) Encode(args ...interface{}) ([]byte, error) {
 func (e *Event) Decode(data []byte, v interface{}) error {
 	return e.argsWithoutIndexed.Unpack(v, data)
 }
+
+// Anonymous returns if the event is anonymous.
+func (e *Event) Anonymous() bool {
+	return e.event.Anonymous
+}
+
+// the index of topic where indexed arguments start.
+func (e *Event) topicOffset() int {
+	if e.event.Anonymous {
+		return 0
+	}
+	return 1
+}
+
+// DecodeArgs decodes all arguments of the event, the indexed ones from topics, and the others from data.
+// Values are keyed by argument name, or by argument position if unnamed.
+// Indexed arguments of dynamic types are only logged as hashes, so their topics are returned as is.
+func (e *Event) DecodeArgs(topics []thor.Bytes32, data []byte) (map[string]interface{}, error) {
+	values, err := e.argsWithoutIndexed.UnpackValues(data)
+	if err != nil {
+		return nil, err
+	}
+	args := make(map[string]interface{}, len(e.event.Inputs))
+	topicIndex := e.topicOffset()
+	for i, arg := range e.event.Inputs {
+		name := arg.Name
+		if name == "" {
+			name = strconv.Itoa(i)
+		}
+		if !arg.Indexed {
+			args[name] = toSigned(arg.Type, values[0])
+			values = values[1:]
+			continue
+		}
+		if topicIndex >= len(topics) {
+			return nil, errors.New("insufficient topics")
+		}
+		topic := topics[topicIndex]
+		topicIndex++
+		if !isTopicDecodable(arg.Type) {
+			args[name] = topic
+			continue
+		}
+		v, err := ethabi.Arguments{{Type: arg.Type}}.UnpackValues(topic[:])
+		if err != nil {
+			return nil, err
+		}
+		args[name] = toSigned(arg.Type, v[0])
+	}
+	return args, nil
+}
+
+// toSigned converts big integer of signed type into two's complement form,
+// since it's always unpacked as unsigned.
+func toSigned(typ ethabi.Type, v interface{}) interface{} {
+	if n, ok := v.(*big.Int); ok && typ.T == ethabi.IntTy {
+		return math.S256(n)
+	}
+	return v
+}
+
+// EncodeTopic encodes the value of the named indexed argument into topic, and returns the index of the topic.
+// The value is in string form, decimal or hex for integers, hex for address and bytes, and 'true' or 'false' for bool.
+// Values of string and bytes are hashed, and other dynamic types are not supported.
+func (e *Event) EncodeTopic(name string, value string) (int, thor.Bytes32, error) {
+	topicIndex := e.topicOffset()
+	for _, arg := range e.event.Inputs {
+		if !arg.Indexed {
+			continue
+		}
+		if arg.Name != name {
+			topicIndex++
+			continue
+		}
+		topic, err := encodeTopic(arg.Type, value)
+		if err != nil {
+			return 0, thor.Bytes32{}, err
+		}
+		return topicIndex, topic, nil
+	}
+	return 0, thor.Bytes32{}, errors.New("indexed argument not found")
+}
+
+// static types are logged as they are encoded.
+func isTopicDecodable(typ ethabi.Type) bool {
+	switch typ.T {
+	case ethabi.IntTy, ethabi.UintTy, ethabi.BoolTy, ethabi.AddressTy, ethabi.FixedBytesTy:
+		return true
+	}
+	return false
+}
+
+func encodeTopic(typ ethabi.Type, value string) (thor.Bytes32, error) {
+	switch typ.T {
+	case ethabi.IntTy, ethabi.UintTy:
+		n, ok := math.ParseBig256(value)
+		if !ok {
+			return thor.Bytes32{}, errors.New("invalid integer")
+		}
+		if typ.T == ethabi.UintTy {
+			if n.Sign() < 0 || n.BitLen() > typ.Size {
+				return thor.Bytes32{}, errors.New("integer out of range")
+			}
+		} else {
+			// -2^(size-1) <= n < 2^(size-1)
+			limit := new(big.Int).Lsh(big.NewInt(1), uint(typ.Size-1))
+			if n.Cmp(limit) >= 0 || n.Cmp(new(big.Int).Neg(limit)) < 0 {
+				return thor.Bytes32{}, errors.New("integer out of range")
+			}
+		}
+		return thor.BytesToBytes32(math.PaddedBigBytes(math.U256(n), 32)), nil
+	case ethabi.BoolTy:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return thor.Bytes32{}, err
+		}
+		if b {
+			return thor.BytesToBytes32([]byte{1}), nil
+		}
+		return thor.Bytes32{}, nil
+	case ethabi.AddressTy:
+		addr, err := thor.ParseAddress(value)
+		if err != nil {
+			return thor.Bytes32{}, err
+		}
+		return thor.BytesToBytes32(addr.Bytes()), nil
+	case ethabi.FixedBytesTy:
+		b, err := hexutil.Decode(value)
+		if err != nil {
+			return thor.Bytes32{}, err
+		}
+		if len(b) > typ.Size {
+			return thor.Bytes32{}, errors.New("bytes too long")
+		}
+		var topic thor.Bytes32
+		copy(topic[:], b)
+		return topic, nil
+	case ethabi.StringTy:
+		return thor.Bytes32(crypto.Keccak256Hash([]byte(value))), nil
+	case ethabi.BytesTy:
+		b, err := hexutil.Decode(value)
+		if err != nil {
+			return thor.Bytes32{}, err
+		}
+		return thor.Bytes32(crypto.Keccak256Hash(b)), nil
+	default:
+		return thor.Bytes32{}, errors.New("unsupported type " + typ.String())
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package abi
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+var (
+	errorMethod = mustParseMethod(`[{"name":"Error","type":"function","inputs":[{"name":"reason","type":"string"}]}]`, "Error")
+	panicMethod = mustParseMethod(`[{"name":"Panic","type":"function","inputs":[{"name":"code","type":"uint256"}]}]`, "Panic")
+
+	// see https://docs.soliditylang.org/en/latest/control-structures.html#panic-via-assert-and-error-via-require
+	panicReasons = map[uint64]string{
+		0x00: "generic panic",
+		0x01: "assert(false)",
+		0x11: "arithmetic underflow or overflow",
+		0x12: "division or modulo by zero",
+		0x21: "enum overflow",
+		0x22: "invalid encoded storage byte array accessed",
+		0x31: "out-of-bounds array access; popping on an empty array",
+		0x32: "out-of-bounds access of an array or bytesN",
+		0x41: "out of memory",
+		0x51: "uninitialized function",
+	}
+)
+
+func mustParseMethod(data string, name string) *Method {
+	abi, err := New([]byte(data))
+	if err != nil {
+		panic(err)
+	}
+	method, found := abi.MethodByName(name)
+	if !found {
+		panic("method not found")
+	}
+	return method
+}
+
+// UnpackRevert decodes the revert data, which is encoded as Error(string) or Panic(uint256).
+// For Panic(uint256), the returned reason is composed of the panic code and its description.
+func UnpackRevert(data []byte) (string, error) {
+	switch {
+	case bytes.HasPrefix(data, errorMethod.id[:]):
+		var reason string
+		if err := errorMethod.DecodeInput(data, &reason); err != nil {
+			return "", err
+		}
+		return reason, nil
+	case bytes.HasPrefix(data, panicMethod.id[:]):
+		var code *big.Int
+		if err := panicMethod.DecodeInput(data, &code); err != nil {
+			return "", err
+		}
+		if code.IsUint64() {
+			if reason, ok := panicReasons[code.Uint64()]; ok {
+				return fmt.Sprintf("panic: %s (0x%x)", reason, code), nil
+			}
+		}
+		return fmt.Sprintf("panic: unknown code (0x%x)", code), nil
+	default:
+		return "", errors.New("not a revert reason")
+	}
+}
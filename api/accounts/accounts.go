@@ -10,7 +10,6 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
-	"strconv"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
@@ -19,6 +18,8 @@ import (
 	"github.com/vechain/thor/api/utils"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/finality"
+	"github.com/vechain/thor/logdb"
 	"github.com/vechain/thor/runtime"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
@@ -27,22 +28,31 @@ import (
 )
 
 type Accounts struct {
-	repo         *chain.Repository
-	stater       *state.Stater
-	callGasLimit uint64
-	forkConfig   thor.ForkConfig
+	repo           *chain.Repository
+	stater         *state.Stater
+	finality       *finality.Finality
+	logDB          *logdb.LogDB
+	callGasLimit   uint64
+	backtraceLimit uint32
+	forkConfig     thor.ForkConfig
 }
 
 func New(
 	repo *chain.Repository,
 	stater *state.Stater,
+	finality *finality.Finality,
+	logDB *logdb.LogDB,
 	callGasLimit uint64,
+	backtraceLimit uint32,
 	forkConfig thor.ForkConfig,
 ) *Accounts {
 	return &Accounts{
 		repo,
 		stater,
+		finality,
+		logDB,
 		callGasLimit,
+		backtraceLimit,
 		forkConfig,
 	}
 }
@@ -63,7 +73,7 @@ func (a *Accounts) handleGetCode(w http.ResponseWriter, req *http.Request) error
 	if err != nil {
 		return utils.BadRequest(errors.WithMessage(err, "address"))
 	}
-	h, err := a.handleRevision(req.URL.Query().Get("revision"))
+	h, err := utils.GetHeaderByRevision(req.URL.Query().Get("revision"), a.repo, a.finality)
 	if err != nil {
 		return err
 	}
@@ -112,7 +122,7 @@ func (a *Accounts) handleGetAccount(w http.ResponseWriter, req *http.Request) er
 	if err != nil {
 		return utils.BadRequest(errors.WithMessage(err, "address"))
 	}
-	h, err := a.handleRevision(req.URL.Query().Get("revision"))
+	h, err := utils.GetHeaderByRevision(req.URL.Query().Get("revision"), a.repo, a.finality)
 	if err != nil {
 		return err
 	}
@@ -132,7 +142,7 @@ func (a *Accounts) handleGetStorage(w http.ResponseWriter, req *http.Request) er
 	if err != nil {
 		return utils.BadRequest(errors.WithMessage(err, "key"))
 	}
-	h, err := a.handleRevision(req.URL.Query().Get("revision"))
+	h, err := utils.GetHeaderByRevision(req.URL.Query().Get("revision"), a.repo, a.finality)
 	if err != nil {
 		return err
 	}
@@ -148,7 +158,7 @@ func (a *Accounts) handleCallContract(w http.ResponseWriter, req *http.Request)
 	if err := utils.ParseJSON(req.Body, &callData); err != nil {
 		return utils.BadRequest(errors.WithMessage(err, "body"))
 	}
-	h, err := a.handleRevision(req.URL.Query().Get("revision"))
+	h, err := utils.GetHeaderByRevision(req.URL.Query().Get("revision"), a.repo, a.finality)
 	if err != nil {
 		return err
 	}
@@ -172,7 +182,7 @@ func (a *Accounts) handleCallContract(w http.ResponseWriter, req *http.Request)
 		GasPrice: callData.GasPrice,
 		Caller:   callData.Caller,
 	}
-	results, err := a.batchCall(req.Context(), batchCallData, h)
+	results, err := a.BatchCall(req.Context(), batchCallData, h)
 	if err != nil {
 		return err
 	}
@@ -184,18 +194,20 @@ func (a *Accounts) handleCallBatchCode(w http.ResponseWriter, req *http.Request)
 	if err := utils.ParseJSON(req.Body, &batchCallData); err != nil {
 		return utils.BadRequest(errors.WithMessage(err, "body"))
 	}
-	h, err := a.handleRevision(req.URL.Query().Get("revision"))
+	h, err := utils.GetHeaderByRevision(req.URL.Query().Get("revision"), a.repo, a.finality)
 	if err != nil {
 		return err
 	}
-	results, err := a.batchCall(req.Context(), batchCallData, h)
+	results, err := a.BatchCall(req.Context(), batchCallData, h)
 	if err != nil {
 		return err
 	}
 	return utils.WriteJSON(w, results)
 }
 
-func (a *Accounts) batchCall(ctx context.Context, batchCallData *BatchCallData, header *block.Header) (results BatchCallResults, err error) {
+// BatchCall executes the clauses of batchCallData sequentially on the state of the given block.
+// It stops at the first reverted clause.
+func (a *Accounts) BatchCall(ctx context.Context, batchCallData *BatchCallData, header *block.Header) (results BatchCallResults, err error) {
 	txCtx, gas, clauses, err := a.handleBatchCallData(batchCallData)
 	if err != nil {
 		return nil, err
@@ -311,46 +323,12 @@ func (a *Accounts) handleBatchCallData(batchCallData *BatchCallData) (txCtx *xen
 	return
 }
 
-func (a *Accounts) handleRevision(revision string) (*block.Header, error) {
-	if revision == "" || revision == "best" {
-		return a.repo.BestBlock().Header(), nil
-	}
-	if len(revision) == 66 || len(revision) == 64 {
-		blockID, err := thor.ParseBytes32(revision)
-		if err != nil {
-			return nil, utils.BadRequest(errors.WithMessage(err, "revision"))
-		}
-		summary, err := a.repo.GetBlockSummary(blockID)
-		if err != nil {
-			if a.repo.IsNotFound(err) {
-				return nil, utils.BadRequest(errors.WithMessage(err, "revision"))
-			}
-			return nil, err
-		}
-		return summary.Header, nil
-	}
-	n, err := strconv.ParseUint(revision, 0, 0)
-	if err != nil {
-		return nil, utils.BadRequest(errors.WithMessage(err, "revision"))
-	}
-	if n > math.MaxUint32 {
-		return nil, utils.BadRequest(errors.WithMessage(errors.New("block number out of max uint32"), "revision"))
-	}
-	h, err := a.repo.NewBestChain().GetBlockHeader(uint32(n))
-	if err != nil {
-		if a.repo.IsNotFound(err) {
-			return nil, utils.BadRequest(errors.WithMessage(err, "revision"))
-		}
-		return nil, err
-	}
-	return h, nil
-}
-
 func (a *Accounts) Mount(root *mux.Router, pathPrefix string) {
 	sub := root.PathPrefix(pathPrefix).Subrouter()
 
 	sub.Path("/*").Methods("POST").HandlerFunc(utils.WrapHandlerFunc(a.handleCallBatchCode))
 	sub.Path("/{address}").Methods(http.MethodGet).HandlerFunc(utils.WrapHandlerFunc(a.handleGetAccount))
+	sub.Path("/{address}/history").Methods(http.MethodGet).HandlerFunc(utils.WrapHandlerFunc(a.handleGetHistory))
 	sub.Path("/{address}/code").Methods(http.MethodGet).HandlerFunc(utils.WrapHandlerFunc(a.handleGetCode))
 	sub.Path("/{address}/storage/{key}").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(a.handleGetStorage))
 	sub.Path("").Methods("POST").HandlerFunc(utils.WrapHandlerFunc(a.handleCallContract))
@@ -24,7 +24,9 @@ import (
 	ABI "github.com/vechain/thor/abi"
 	"github.com/vechain/thor/api/accounts"
 	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/finality"
 	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/logdb"
 	"github.com/vechain/thor/muxdb"
 	"github.com/vechain/thor/packer"
 	"github.com/vechain/thor/state"
@@ -98,6 +100,7 @@ var invalidBytes32 = "0x00000000000000000000000000000000000000000000000000000000
 var invalidNumberRevision = "4294967296"                                                  //invalid block number
 
 var ts *httptest.Server
+var logDB *logdb.LogDB
 
 func TestAccount(t *testing.T) {
 	initAccountServer(t)
@@ -105,6 +108,7 @@ func TestAccount(t *testing.T) {
 	getAccount(t)
 	getCode(t)
 	getStorage(t)
+	getHistory(t)
 	deployContractWithCall(t)
 	callContract(t)
 	batchCall(t)
@@ -173,6 +177,48 @@ func getStorage(t *testing.T) {
 	assert.Equal(t, http.StatusOK, statusCode, "OK")
 }
 
+func getHistory(t *testing.T) {
+	_, statusCode := httpGet(t, ts.URL+"/accounts/"+addr.String()+"/history?step=0")
+	assert.Equal(t, http.StatusBadRequest, statusCode, "bad step")
+
+	_, statusCode = httpGet(t, ts.URL+"/accounts/"+addr.String()+"/history?from=2&to=1")
+	assert.Equal(t, http.StatusBadRequest, statusCode, "bad range")
+
+	// paged by backtrace limit
+	res, statusCode := httpGet(t, ts.URL+"/accounts/"+addr.String()+"/history")
+	assert.Equal(t, http.StatusOK, statusCode)
+	var history accounts.AccountHistory
+	if err := json.Unmarshal(res, &history); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, len(history.Entries))
+	assert.Equal(t, uint32(0), history.Entries[0].BlockNumber)
+	assert.Equal(t, big.NewInt(0), (*big.Int)(&history.Entries[0].Balance))
+	assert.Equal(t, uint32(1), history.Entries[1].BlockNumber)
+	assert.Equal(t, value, (*big.Int)(&history.Entries[1].Balance))
+	if assert.NotNil(t, history.Next) {
+		assert.Equal(t, uint32(2), *history.Next)
+	}
+
+	res, _ = httpGet(t, ts.URL+"/accounts/"+contractAddr.String()+"/history?from=1&step=2")
+	history = accounts.AccountHistory{}
+	if err := json.Unmarshal(res, &history); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(history.Entries))
+	assert.Equal(t, thor.Bytes32(crypto.Keccak256Hash(runtimeBytecode)), history.Entries[0].CodeHash)
+	assert.Nil(t, history.Next)
+
+	res, _ = httpGet(t, ts.URL+"/accounts/"+addr.String()+"/history?balanceChanged=true")
+	history = accounts.AccountHistory{}
+	if err := json.Unmarshal(res, &history); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(history.Entries))
+	assert.Equal(t, uint32(1), history.Entries[0].BlockNumber)
+	assert.Nil(t, history.Next)
+}
+
 func initAccountServer(t *testing.T) {
 	db := muxdb.NewMem()
 	stater := state.NewStater(db)
@@ -183,6 +229,10 @@ func initAccountServer(t *testing.T) {
 		t.Fatal(err)
 	}
 	repo, _ := chain.NewRepository(db, b)
+	logDB, err = logdb.NewMem()
+	if err != nil {
+		t.Fatal(err)
+	}
 	claTransfer := tx.NewClause(&addr).WithValue(value)
 	claDeploy := tx.NewClause(nil).WithData(bytecode)
 	transaction := buildTxWithClauses(t, repo.ChainTag(), claTransfer, claDeploy)
@@ -201,7 +251,7 @@ func initAccountServer(t *testing.T) {
 	packTx(repo, stater, transactionCall, t)
 
 	router := mux.NewRouter()
-	accounts.New(repo, stater, math.MaxUint64, thor.NoFork).Mount(router, "/accounts")
+	accounts.New(repo, stater, finality.New(repo, stater), logDB, math.MaxUint64, 2, thor.NoFork).Mount(router, "/accounts")
 	ts = httptest.NewServer(router)
 }
 
@@ -240,6 +290,11 @@ func packTx(repo *chain.Repository, stater *state.Stater, transaction *tx.Transa
 	if err := repo.AddBlock(b, receipts); err != nil {
 		t.Fatal(err)
 	}
+	if err := logDB.Log(func(w *logdb.Writer) error {
+		return w.Write(b, receipts)
+	}); err != nil {
+		t.Fatal(err)
+	}
 	if err := repo.SetBestBlockID(b.Header().ID()); err != nil {
 		t.Fatal(err)
 	}
@@ -0,0 +1,195 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package accounts
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/logdb"
+	"github.com/vechain/thor/thor"
+)
+
+// page size to query transfer logs for balance changed blocks.
+const transferPageSize = 1000
+
+func (a *Accounts) handleGetHistory(w http.ResponseWriter, req *http.Request) error {
+	addr, err := thor.ParseAddress(mux.Vars(req)["address"])
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "address"))
+	}
+	query := req.URL.Query()
+	best := a.repo.BestBlock().Header().Number()
+
+	from, err := parseBlockNumber(query.Get("from"), 0)
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "from"))
+	}
+	to, err := parseBlockNumber(query.Get("to"), best)
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "to"))
+	}
+	if to > best {
+		to = best
+	}
+	if from > to {
+		return utils.BadRequest(errors.New("from: greater than to"))
+	}
+
+	step := uint64(1)
+	if s := query.Get("step"); s != "" {
+		if step, err = strconv.ParseUint(s, 0, 32); err != nil {
+			return utils.BadRequest(errors.WithMessage(err, "step"))
+		}
+		if step == 0 {
+			return utils.BadRequest(errors.New("step: should be greater than 0"))
+		}
+	}
+
+	balanceChanged := false
+	if s := query.Get("balanceChanged"); s != "" {
+		if balanceChanged, err = strconv.ParseBool(s); err != nil {
+			return utils.BadRequest(errors.WithMessage(err, "balanceChanged"))
+		}
+	}
+
+	var history *AccountHistory
+	if balanceChanged {
+		history, err = a.getBalanceChangedHistory(req.Context(), addr, from, to)
+	} else {
+		history, err = a.sampleHistory(req.Context(), addr, from, to, step)
+	}
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, history)
+}
+
+// sampleHistory samples account states every step blocks in range [from, to].
+// At most backtraceLimit entries are returned, and the rest is indicated by history.Next.
+func (a *Accounts) sampleHistory(ctx context.Context, addr thor.Address, from, to uint32, step uint64) (*AccountHistory, error) {
+	chain := a.repo.NewBestChain()
+	history := &AccountHistory{Entries: []*AccountHistoryEntry{}}
+	for n := uint64(from); n <= uint64(to); n += step {
+		if len(history.Entries) >= int(a.backtraceLimit) {
+			next := uint32(n)
+			history.Next = &next
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		header, err := chain.GetBlockHeader(uint32(n))
+		if err != nil {
+			return nil, err
+		}
+		entry, err := a.getHistoryEntry(addr, header)
+		if err != nil {
+			return nil, err
+		}
+		history.Entries = append(history.Entries, entry)
+	}
+	return history, nil
+}
+
+// getBalanceChangedHistory returns account states at blocks in range [from, to], where VET transferred from or to the account.
+// Blocks are located by the transfer logs, so only changes of VET balance are tracked. Energy and code may change
+// in other blocks without being reported, e.g. by energy growth, gas payment or contract deployment.
+// At most backtraceLimit entries are returned, and the rest is indicated by history.Next.
+func (a *Accounts) getBalanceChangedHistory(ctx context.Context, addr thor.Address, from, to uint32) (*AccountHistory, error) {
+	if a.logDB == nil {
+		return nil, utils.Forbidden(errors.New("balanceChanged: logs are disabled"))
+	}
+
+	filter := &logdb.TransferFilter{
+		CriteriaSet: []*logdb.TransferCriteria{{Sender: &addr}, {Recipient: &addr}},
+		Range:       &logdb.Range{From: from, To: to},
+		Options:     &logdb.Options{Limit: transferPageSize},
+		Order:       logdb.ASC,
+	}
+	var nums []uint32
+	for {
+		transfers, err := a.logDB.FilterTransfers(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range transfers {
+			if n := len(nums); n == 0 || nums[n-1] != t.BlockNumber {
+				nums = append(nums, t.BlockNumber)
+			}
+		}
+		if len(nums) > int(a.backtraceLimit) || len(transfers) < transferPageSize {
+			break
+		}
+		last := transfers[len(transfers)-1]
+		filter.Options.Cursor = &logdb.Cursor{BlockNumber: last.BlockNumber, Index: last.Index}
+	}
+
+	history := &AccountHistory{Entries: []*AccountHistoryEntry{}}
+	if len(nums) > int(a.backtraceLimit) {
+		next := nums[a.backtraceLimit]
+		history.Next = &next
+		nums = nums[:a.backtraceLimit]
+	}
+
+	chain := a.repo.NewBestChain()
+	for _, n := range nums {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		header, err := chain.GetBlockHeader(n)
+		if err != nil {
+			return nil, err
+		}
+		entry, err := a.getHistoryEntry(addr, header)
+		if err != nil {
+			return nil, err
+		}
+		history.Entries = append(history.Entries, entry)
+	}
+	return history, nil
+}
+
+func (a *Accounts) getHistoryEntry(addr thor.Address, header *block.Header) (*AccountHistoryEntry, error) {
+	state := a.stater.NewState(header.StateRoot())
+	balance, err := state.GetBalance(addr)
+	if err != nil {
+		return nil, err
+	}
+	energy, err := state.GetEnergy(addr, header.Timestamp())
+	if err != nil {
+		return nil, err
+	}
+	codeHash, err := state.GetCodeHash(addr)
+	if err != nil {
+		return nil, err
+	}
+	return &AccountHistoryEntry{
+		BlockID:        header.ID(),
+		BlockNumber:    header.Number(),
+		BlockTimestamp: header.Timestamp(),
+		Balance:        math.HexOrDecimal256(*balance),
+		Energy:         math.HexOrDecimal256(*energy),
+		CodeHash:       codeHash,
+	}, nil
+}
+
+func parseBlockNumber(s string, def uint32) (uint32, error) {
+	if s == "" {
+		return def, nil
+	}
+	n, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(n), nil
+}
@@ -8,6 +8,7 @@ package accounts
 import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/vechain/thor/abi"
 	"github.com/vechain/thor/api/transactions"
 	"github.com/vechain/thor/runtime"
 	"github.com/vechain/thor/thor"
@@ -20,6 +21,23 @@ type Account struct {
 	HasCode bool                 `json:"hasCode"`
 }
 
+// AccountHistory for marshal account history.
+// Next is the 'from' to query the next page, or null if no more.
+type AccountHistory struct {
+	Entries []*AccountHistoryEntry `json:"entries"`
+	Next    *uint32                `json:"next"`
+}
+
+// AccountHistoryEntry account state at a block.
+type AccountHistoryEntry struct {
+	BlockID        thor.Bytes32         `json:"blockID"`
+	BlockNumber    uint32               `json:"blockNumber"`
+	BlockTimestamp uint64               `json:"blockTimestamp"`
+	Balance        math.HexOrDecimal256 `json:"balance"`
+	Energy         math.HexOrDecimal256 `json:"energy"`
+	CodeHash       thor.Bytes32         `json:"codeHash"`
+}
+
 //CallData represents contract-call body
 type CallData struct {
 	Value    *math.HexOrDecimal256 `json:"value"`
@@ -30,24 +48,27 @@ type CallData struct {
 }
 
 type CallResult struct {
-	Data      string                   `json:"data"`
-	Events    []*transactions.Event    `json:"events"`
-	Transfers []*transactions.Transfer `json:"transfers"`
-	GasUsed   uint64                   `json:"gasUsed"`
-	Reverted  bool                     `json:"reverted"`
-	VMError   string                   `json:"vmError"`
+	Data         string                   `json:"data"`
+	Events       []*transactions.Event    `json:"events"`
+	Transfers    []*transactions.Transfer `json:"transfers"`
+	GasUsed      uint64                   `json:"gasUsed"`
+	Reverted     bool                     `json:"reverted"`
+	VMError      string                   `json:"vmError"`
+	RevertReason string                   `json:"revertReason"`
 }
 
 func convertCallResultWithInputGas(vo *runtime.Output, inputGas uint64) *CallResult {
 	gasUsed := inputGas - vo.LeftOverGas
 	var (
-		vmError  string
-		reverted bool
+		vmError      string
+		reverted     bool
+		revertReason string
 	)
 
 	if vo.VMErr != nil {
 		reverted = true
 		vmError = vo.VMErr.Error()
+		revertReason, _ = abi.UnpackRevert(vo.Data)
 	}
 
 	events := make([]*transactions.Event, len(vo.Events))
@@ -74,12 +95,13 @@ func convertCallResultWithInputGas(vo *runtime.Output, inputGas uint64) *CallRes
 	}
 
 	return &CallResult{
-		Data:      hexutil.Encode(vo.Data),
-		Events:    events,
-		Transfers: transfers,
-		GasUsed:   gasUsed,
-		Reverted:  reverted,
-		VMError:   vmError,
+		Data:         hexutil.Encode(vo.Data),
+		Events:       events,
+		Transfers:    transfers,
+		GasUsed:      gasUsed,
+		Reverted:     reverted,
+		VMError:      vmError,
+		RevertReason: revertReason,
 	}
 }
 
@@ -9,26 +9,41 @@ import (
 	"net/http"
 	"net/http/pprof"
 	"strings"
+	"time"
 
 	assetfs "github.com/elazarl/go-bindata-assetfs"
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
 	"github.com/vechain/thor/api/accounts"
 	"github.com/vechain/thor/api/blocks"
 	"github.com/vechain/thor/api/debug"
 	"github.com/vechain/thor/api/doc"
 	"github.com/vechain/thor/api/events"
+	"github.com/vechain/thor/api/jsonrpc"
 	"github.com/vechain/thor/api/node"
+	"github.com/vechain/thor/api/pool"
 	"github.com/vechain/thor/api/subscriptions"
 	"github.com/vechain/thor/api/transactions"
 	"github.com/vechain/thor/api/transfers"
 	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/finality"
+	"github.com/vechain/thor/liveness"
 	"github.com/vechain/thor/logdb"
+	"github.com/vechain/thor/metric"
+	"github.com/vechain/thor/packer"
+	"github.com/vechain/thor/reorg"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/txpool"
 )
 
+var metricRequestDuration = metric.NewHistogramVec(
+	"thor_api_request_duration_seconds",
+	"Time spent serving API requests, by route.",
+	nil,
+	"route", "method")
+
 //New return api router
 func New(
 	repo *chain.Repository,
@@ -36,6 +51,9 @@ func New(
 	txPool *txpool.TxPool,
 	logDB *logdb.LogDB,
 	nw node.Network,
+	tracker *liveness.Tracker,
+	reorgs *reorg.Journal,
+	strategy packer.Strategy,
 	allowedOrigins string,
 	backtraceLimit uint32,
 	callGasLimit uint64,
@@ -65,8 +83,13 @@ func New(
 			http.Redirect(w, req, "doc/swagger-ui/", http.StatusTemporaryRedirect)
 		})
 
-	accounts.New(repo, stater, callGasLimit, forkConfig).
-		Mount(router, "/accounts")
+	var accountsLogDB *logdb.LogDB
+	if !skipLogs {
+		accountsLogDB = logDB
+	}
+	fin := finality.New(repo, stater)
+	accs := accounts.New(repo, stater, fin, accountsLogDB, callGasLimit, backtraceLimit, forkConfig)
+	accs.Mount(router, "/accounts")
 
 	if !skipLogs {
 		events.New(repo, logDB).
@@ -74,17 +97,26 @@ func New(
 		transfers.New(repo, logDB).
 			Mount(router, "/logs/transfer")
 	}
-	blocks.New(repo).
+	blocks.New(repo, fin).
 		Mount(router, "/blocks")
-	transactions.New(repo, txPool).
+	transactions.New(repo, stater, txPool, fin, callGasLimit, forkConfig).
 		Mount(router, "/transactions")
-	debug.New(repo, stater, forkConfig).
+	debug.New(repo, stater, txPool, strategy, forkConfig).
 		Mount(router, "/debug")
-	node.New(nw).
+	node.New(nw, repo, stater, tracker, reorgs).
 		Mount(router, "/node")
-	subs := subscriptions.New(repo, origins, backtraceLimit)
+	pool.New(txPool).
+		Mount(router, "/txpool")
+	subs := subscriptions.New(repo, origins, backtraceLimit, txPool, reorgs)
 	subs.Mount(router, "/subscriptions")
 
+	var rpcLogDB *logdb.LogDB
+	if !skipLogs {
+		rpcLogDB = logDB
+	}
+	rpc := jsonrpc.New(repo, stater, txPool, rpcLogDB, accs, origins)
+	rpc.Mount(router, "/jsonrpc")
+
 	if pprofOn {
 		router.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
 		router.HandleFunc("/debug/pprof/profile", pprof.Profile)
@@ -94,11 +126,61 @@ func New(
 	}
 
 	handler := handlers.CompressHandler(router)
+	handler = handleRequestMetrics(router, handler)
 	handler = handlers.CORS(
 		handlers.AllowedOrigins(origins),
 		handlers.AllowedHeaders([]string{"content-type", "x-genesis-id"}),
-		handlers.ExposedHeaders([]string{"x-genesis-id", "x-thorest-ver"}),
+		handlers.ExposedHeaders([]string{"x-genesis-id", "x-thorest-ver", "x-reject-code"}),
 	)(handler)
 	return handler.ServeHTTP,
-		subs.Close // subscriptions handles hijacked conns, which need to be closed
+		func() {
+			// subscriptions and jsonrpc handle hijacked conns, which need to be closed
+			subs.Close()
+			rpc.Close()
+		}
+}
+
+// NewAdmin return admin api router, which serves APIs to manipulate the node.
+// It should only be exposed to operators.
+func NewAdmin(txPool *txpool.TxPool) http.HandlerFunc {
+	router := mux.NewRouter()
+	pool.New(txPool).
+		MountAdmin(router, "/txpool")
+	return router.ServeHTTP
+}
+
+// methods used as metric label values, others are labeled as "other" to bound the count of series.
+var knownMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodPost:    true,
+	http.MethodPut:     true,
+	http.MethodPatch:   true,
+	http.MethodDelete:  true,
+	http.MethodOptions: true,
+}
+
+// middleware to measure request duration by matched route template.
+// Websocket upgrades are skipped, since they last as long as the conn.
+func handleRequestMetrics(router *mux.Router, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if websocket.IsWebSocketUpgrade(req) {
+			h.ServeHTTP(w, req)
+			return
+		}
+		method := req.Method
+		if !knownMethods[method] {
+			method = "other"
+		}
+		route := "unmatched"
+		var match mux.RouteMatch
+		if router.Match(req, &match) && match.Route != nil {
+			if tpl, err := match.Route.GetPathTemplate(); err == nil {
+				route = tpl
+			}
+		}
+		startTime := time.Now()
+		h.ServeHTTP(w, req)
+		metricRequestDuration.With(route, method).Observe(time.Since(startTime).Seconds())
+	})
 }
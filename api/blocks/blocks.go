@@ -7,28 +7,29 @@ package blocks
 
 import (
 	"net/http"
-	"strconv"
 
-	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 	"github.com/vechain/thor/api/utils"
 	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/finality"
 	"github.com/vechain/thor/thor"
 )
 
 type Blocks struct {
-	repo *chain.Repository
+	repo     *chain.Repository
+	finality *finality.Finality
 }
 
-func New(repo *chain.Repository) *Blocks {
+func New(repo *chain.Repository, finality *finality.Finality) *Blocks {
 	return &Blocks{
 		repo,
+		finality,
 	}
 }
 
 func (b *Blocks) handleGetBlock(w http.ResponseWriter, req *http.Request) error {
-	revision, err := b.parseRevision(mux.Vars(req)["revision"])
+	revision, err := utils.ParseRevision(mux.Vars(req)["revision"])
 	if err != nil {
 		return utils.BadRequest(errors.WithMessage(err, "revision"))
 	}
@@ -37,7 +38,7 @@ func (b *Blocks) handleGetBlock(w http.ResponseWriter, req *http.Request) error
 		return utils.BadRequest(errors.WithMessage(errors.New("should be boolean"), "expanded"))
 	}
 
-	summary, err := b.getBlockSummary(revision)
+	summary, err := utils.GetSummaryByRevision(revision, b.repo, b.finality)
 	if err != nil {
 		if b.repo.IsNotFound(err) {
 			return utils.WriteJSON(w, nil)
@@ -49,7 +50,14 @@ func (b *Blocks) handleGetBlock(w http.ResponseWriter, req *http.Request) error
 		return err
 	}
 
+	confirmations, finalized, err := b.finality.Status(summary.Header)
+	if err != nil {
+		return err
+	}
+
 	jSummary := buildJSONBlockSummary(summary, isTrunk)
+	jSummary.Confirmations = confirmations
+	jSummary.Finalized = finalized
 	if expanded == "true" {
 		txs, err := b.repo.GetBlockTransactions(summary.Header.ID())
 		if err != nil {
@@ -72,43 +80,6 @@ func (b *Blocks) handleGetBlock(w http.ResponseWriter, req *http.Request) error
 	})
 }
 
-func (b *Blocks) parseRevision(revision string) (interface{}, error) {
-	if revision == "" || revision == "best" {
-		return nil, nil
-	}
-	if len(revision) == 66 || len(revision) == 64 {
-		blockID, err := thor.ParseBytes32(revision)
-		if err != nil {
-			return nil, err
-		}
-		return blockID, nil
-	}
-	n, err := strconv.ParseUint(revision, 0, 0)
-	if err != nil {
-		return nil, err
-	}
-	if n > math.MaxUint32 {
-		return nil, errors.New("block number out of max uint32")
-	}
-	return uint32(n), err
-}
-
-func (b *Blocks) getBlockSummary(revision interface{}) (s *chain.BlockSummary, err error) {
-	var id thor.Bytes32
-	switch revision.(type) {
-	case thor.Bytes32:
-		id = revision.(thor.Bytes32)
-	case uint32:
-		id, err = b.repo.NewBestChain().GetBlockID(revision.(uint32))
-		if err != nil {
-			return
-		}
-	default:
-		id = b.repo.BestBlock().Header().ID()
-	}
-	return b.repo.GetBlockSummary(id)
-}
-
 func (b *Blocks) isTrunk(blkID thor.Bytes32, blkNum uint32) (bool, error) {
 	idByNum, err := b.repo.NewBestChain().GetBlockID(blkNum)
 	if err != nil {
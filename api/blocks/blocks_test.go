@@ -19,6 +19,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/finality"
 	"github.com/vechain/thor/genesis"
 	"github.com/vechain/thor/muxdb"
 	"github.com/vechain/thor/packer"
@@ -69,6 +70,17 @@ func TestBlock(t *testing.T) {
 	}
 	checkBlock(t, blk, rb)
 	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, uint32(0), rb.Confirmations)
+	assert.False(t, rb.Finalized)
+
+	res, statusCode = httpGet(t, ts.URL+"/blocks/finalized")
+	if err := json.Unmarshal(res, &rb); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, uint32(0), rb.Number, "genesis finalized by the block on top")
+	assert.Equal(t, uint32(1), rb.Confirmations)
+	assert.True(t, rb.Finalized)
 
 }
 
@@ -122,7 +134,7 @@ func initBlockServer(t *testing.T) {
 		t.Fatal(err)
 	}
 	router := mux.NewRouter()
-	New(repo).Mount(router, "/blocks")
+	New(repo, finality.New(repo, stater)).Mount(router, "/blocks")
 	ts = httptest.NewServer(router)
 	blk = block
 }
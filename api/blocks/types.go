@@ -29,6 +29,10 @@ type JSONBlockSummary struct {
 	ReceiptsRoot thor.Bytes32 `json:"receiptsRoot"`
 	Signer       thor.Address `json:"signer"`
 	IsTrunk      bool         `json:"isTrunk"`
+
+	// count of trunk blocks on top of it, 0 if not in trunk
+	Confirmations uint32 `json:"confirmations"`
+	Finalized     bool   `json:"finalized"`
 }
 
 type JSONCollapsedBlock struct {
@@ -11,35 +11,48 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
+	"github.com/vechain/thor/abi"
 	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/consensus"
 	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/packer"
 	"github.com/vechain/thor/runtime"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tracers"
 	"github.com/vechain/thor/trie"
+	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/txpool"
 	"github.com/vechain/thor/vm"
 )
 
 var devNetGenesisID = thor.MustParseBytes32("0x00000000973ceb7f343a58b08f0693d6701a5fd354ff73d7058af3fba222aea4")
 
+// max count of raw txs to preview packing
+const maxPreviewTxs = 1000
+
 type Debug struct {
 	repo       *chain.Repository
 	stater     *state.Stater
+	txPool     *txpool.TxPool
+	strategy   packer.Strategy
 	forkConfig thor.ForkConfig
 }
 
-func New(repo *chain.Repository, stater *state.Stater, forkConfig thor.ForkConfig) *Debug {
+func New(repo *chain.Repository, stater *state.Stater, txPool *txpool.TxPool, strategy packer.Strategy, forkConfig thor.ForkConfig) *Debug {
 	return &Debug{
 		repo,
 		stater,
+		txPool,
+		strategy,
 		forkConfig,
 	}
 }
@@ -111,11 +124,16 @@ func (d *Debug) traceTransaction(ctx context.Context, tracer vm.Tracer, blockID
 	}
 	switch tr := tracer.(type) {
 	case *vm.StructLogger:
+		var revertReason string
+		if output.VMErr != nil {
+			revertReason, _ = abi.UnpackRevert(output.Data)
+		}
 		return &ExecutionResult{
-			Gas:         gasUsed,
-			Failed:      output.VMErr != nil,
-			ReturnValue: hexutil.Encode(output.Data),
-			StructLogs:  formatLogs(tr.StructLogs()),
+			Gas:          gasUsed,
+			Failed:       output.VMErr != nil,
+			ReturnValue:  hexutil.Encode(output.Data),
+			RevertReason: revertReason,
+			StructLogs:   formatLogs(tr.StructLogs()),
 		}, nil
 	case *tracers.Tracer:
 		return tr.GetResult()
@@ -223,6 +241,106 @@ func (d *Debug) handleDebugStorage(w http.ResponseWriter, req *http.Request) err
 	return utils.WriteJSON(w, res)
 }
 
+// packPreview simulates packing candidates upon the best block with the packing strategy of the node,
+// without signing or committing anything.
+func (d *Debug) packPreview(candidates []*packer.Candidate, gasLimit uint64) (*PackPreview, error) {
+	best := d.repo.BestBlock().Header()
+
+	// the earliest slot not before now
+	timestamp := best.Timestamp() + thor.BlockInterval
+	if now := uint64(time.Now().Unix()); now > timestamp {
+		timestamp += (now - timestamp) / thor.BlockInterval * thor.BlockInterval
+	}
+
+	if gasLimit != 0 {
+		// limited to the range valid for the next block
+		gasLimit = block.GasLimit(gasLimit).Qualify(best.GasLimit())
+	}
+
+	// node master is irrelevant, since PoA is skipped when mocking
+	flow, err := packer.New(d.repo, d.stater, thor.Address{}, nil, d.forkConfig).Mock(best, timestamp, gasLimit)
+	if err != nil {
+		return nil, err
+	}
+	d.strategy.Fill(flow, candidates)
+
+	preview := &PackPreview{
+		ParentID:     best.ID(),
+		Number:       best.Number() + 1,
+		Timestamp:    timestamp,
+		GasLimit:     flow.GasLimit(),
+		GasUsed:      flow.GasUsed(),
+		ReceiptsRoot: flow.Receipts().RootHash(),
+		Txs:          []*PreviewTx{},
+		Skipped:      []*SkippedTx{},
+	}
+	adopted := make(map[thor.Bytes32]bool)
+	receipts := flow.Receipts()
+	for i, tx := range flow.Txs() {
+		adopted[tx.ID()] = true
+		origin, _ := tx.Origin()
+		preview.Txs = append(preview.Txs, &PreviewTx{
+			ID:       tx.ID(),
+			Origin:   origin,
+			Gas:      tx.Gas(),
+			GasUsed:  receipts[i].GasUsed,
+			Reverted: receipts[i].Reverted,
+		})
+	}
+	for _, c := range candidates {
+		id := c.Tx.ID()
+		switch {
+		case adopted[id]:
+		case c.Err != nil:
+			preview.Skipped = append(preview.Skipped, &SkippedTx{id, c.Err.Error()})
+		default:
+			// skipped by the strategy, e.g. after the block is full
+			preview.Skipped = append(preview.Skipped, &SkippedTx{id, "not tried"})
+		}
+	}
+	return preview, nil
+}
+
+func (d *Debug) handlePackPreview(w http.ResponseWriter, req *http.Request) error {
+	var opt *PackPreviewOption
+	if err := utils.ParseJSON(req.Body, &opt); err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "body"))
+	}
+
+	var candidates []*packer.Candidate
+	if opt == nil || opt.Txs == nil {
+		for _, tx := range d.txPool.Executables() {
+			candidates = append(candidates, &packer.Candidate{Tx: tx, Arrival: d.txPool.TimeAdded(tx.ID())})
+		}
+	} else {
+		if len(opt.Txs) > maxPreviewTxs {
+			return utils.BadRequest(fmt.Errorf("txs: more than %d", maxPreviewTxs))
+		}
+		// raw txs are taken as arrived in the given order
+		now := time.Now()
+		for i, raw := range opt.Txs {
+			data, err := hexutil.Decode(raw)
+			if err != nil {
+				return utils.BadRequest(errors.WithMessage(err, fmt.Sprintf("txs[%d]", i)))
+			}
+			var trx *tx.Transaction
+			if err := rlp.DecodeBytes(data, &trx); err != nil {
+				return utils.BadRequest(errors.WithMessage(err, fmt.Sprintf("txs[%d]", i)))
+			}
+			candidates = append(candidates, &packer.Candidate{Tx: trx, Arrival: now.Add(time.Duration(i))})
+		}
+	}
+	var gasLimit uint64
+	if opt != nil {
+		gasLimit = opt.GasLimit
+	}
+	res, err := d.packPreview(candidates, gasLimit)
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, res)
+}
+
 func (d *Debug) parseTarget(target string) (blockID thor.Bytes32, txIndex uint64, clauseIndex uint64, err error) {
 	parts := strings.Split(target, "/")
 	if len(parts) != 3 {
@@ -265,5 +383,6 @@ func (d *Debug) Mount(root *mux.Router, pathPrefix string) {
 
 	sub.Path("/tracers").Methods(http.MethodPost).HandlerFunc(utils.WrapHandlerFunc(d.handleTraceTransaction))
 	sub.Path("/storage-range").Methods(http.MethodPost).HandlerFunc(utils.WrapHandlerFunc(d.handleDebugStorage))
+	sub.Path("/pack-preview").Methods(http.MethodPost).HandlerFunc(utils.WrapHandlerFunc(d.handlePackPreview))
 
 }
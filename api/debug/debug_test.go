@@ -0,0 +1,171 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package debug_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/api/debug"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/packer"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/txpool"
+)
+
+var (
+	repo   *chain.Repository
+	stater *state.Stater
+	ts     *httptest.Server
+)
+
+func TestPackPreview(t *testing.T) {
+	pool := initDebugServer(t)
+	defer pool.Close()
+	defer ts.Close()
+
+	genesisHeader := repo.GenesisBlock().Header()
+	tx1 := newTx(t, repo.ChainTag(), 0, 1)
+	tx2 := newTx(t, repo.ChainTag(), 10, 2) // not adoptable until block 10
+	tx3 := newTx(t, repo.ChainTag()+1, 0, 3)
+
+	var preview debug.PackPreview
+	status := httpPost(t, ts.URL+"/debug/pack-preview", &debug.PackPreviewOption{
+		Txs: []string{encodeTx(t, tx1), encodeTx(t, tx2), encodeTx(t, tx3)},
+	}, &preview)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, genesisHeader.ID(), preview.ParentID)
+	assert.Equal(t, uint32(1), preview.Number)
+	if assert.Equal(t, 1, len(preview.Txs)) {
+		assert.Equal(t, tx1.ID(), preview.Txs[0].ID)
+		assert.Equal(t, genesis.DevAccounts()[0].Address, preview.Txs[0].Origin)
+		assert.Equal(t, uint64(21000), preview.Txs[0].GasUsed)
+		assert.False(t, preview.Txs[0].Reverted)
+	}
+	assert.Equal(t, []*debug.SkippedTx{
+		{ID: tx2.ID(), Reason: "tx not adoptable now"},
+		{ID: tx3.ID(), Reason: "chain tag mismatch"},
+	}, preview.Skipped)
+
+	// same as the flow packing tx1
+	flow, err := packer.New(repo, stater, thor.Address{}, nil, thor.NoFork).Mock(genesisHeader, preview.Timestamp, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, flow.Adopt(tx1))
+	assert.Equal(t, genesisHeader.GasLimit(), flow.GasLimit())
+	assert.Equal(t, flow.GasLimit(), preview.GasLimit)
+	assert.Equal(t, uint64(21000), flow.GasUsed())
+	assert.Equal(t, flow.GasUsed(), preview.GasUsed)
+	assert.Equal(t, 1, len(flow.Receipts()))
+	assert.Equal(t, flow.Receipts().RootHash(), preview.ReceiptsRoot)
+	assert.Equal(t, tx.Transactions{tx1}, flow.Txs())
+
+	// gas limit limited to the range valid for the next block
+	preview = debug.PackPreview{}
+	status = httpPost(t, ts.URL+"/debug/pack-preview", &debug.PackPreviewOption{
+		Txs:      []string{},
+		GasLimit: math.MaxUint64,
+	}, &preview)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, block.GasLimit(genesisHeader.GasLimit()).Adjust(math.MaxInt64), preview.GasLimit)
+	assert.Empty(t, preview.Txs)
+
+	// executables in the pool, none yet
+	preview = debug.PackPreview{}
+	status = httpPost(t, ts.URL+"/debug/pack-preview", nil, &preview)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Empty(t, preview.Txs)
+	assert.Empty(t, preview.Skipped)
+
+	// too many txs
+	raws := make([]string, 1001)
+	for i := range raws {
+		raws[i] = encodeTx(t, tx1)
+	}
+	status = httpPost(t, ts.URL+"/debug/pack-preview", &debug.PackPreviewOption{Txs: raws}, nil)
+	assert.Equal(t, http.StatusBadRequest, status)
+}
+
+func newTx(t *testing.T, chainTag byte, blockRef uint32, nonce uint64) *tx.Transaction {
+	to := thor.BytesToAddress([]byte("to"))
+	trx := new(tx.Builder).
+		ChainTag(chainTag).
+		Expiration(100).
+		Gas(21000).
+		Nonce(nonce).
+		Clause(tx.NewClause(&to).WithValue(big.NewInt(1))).
+		BlockRef(tx.NewBlockRef(blockRef)).
+		Build()
+	sig, err := crypto.Sign(trx.SigningHash().Bytes(), genesis.DevAccounts()[0].PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return trx.WithSignature(sig)
+}
+
+func encodeTx(t *testing.T, trx *tx.Transaction) string {
+	data, err := rlp.EncodeToBytes(trx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hexutil.Encode(data)
+}
+
+func httpPost(t *testing.T, url string, obj interface{}, result interface{}) int {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := http.Post(url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result != nil && res.StatusCode == http.StatusOK {
+		if err := json.Unmarshal(r, result); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return res.StatusCode
+}
+
+func initDebugServer(t *testing.T) *txpool.TxPool {
+	db := muxdb.NewMem()
+	stater = state.NewStater(db)
+	b, _, _, err := genesis.NewDevnet().Build(stater)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, _ = chain.NewRepository(db, b)
+	pool := txpool.New(repo, stater, txpool.Options{Limit: 10000, LimitPerAccount: 16, MaxLifetime: 10 * time.Minute})
+
+	router := mux.NewRouter()
+	debug.New(repo, stater, pool, packer.GasPriceStrategy{}, thor.NoFork).Mount(router, "/debug")
+	ts = httptest.NewServer(router)
+	return pool
+}
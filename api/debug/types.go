@@ -15,10 +15,11 @@ type TracerOption struct {
 }
 
 type ExecutionResult struct {
-	Gas         uint64         `json:"gas"`
-	Failed      bool           `json:"failed"`
-	ReturnValue string         `json:"returnValue"`
-	StructLogs  []StructLogRes `json:"structLogs"`
+	Gas          uint64         `json:"gas"`
+	Failed       bool           `json:"failed"`
+	ReturnValue  string         `json:"returnValue"`
+	RevertReason string         `json:"revertReason"`
+	StructLogs   []StructLogRes `json:"structLogs"`
 }
 
 type StructLogRes struct {
@@ -88,3 +89,32 @@ type StorageEntry struct {
 	Key   *thor.Bytes32 `json:"key"`
 	Value *thor.Bytes32 `json:"value"`
 }
+
+type PackPreviewOption struct {
+	Txs      []string `json:"txs"`      // raw txs to be packed, taken as arrived in order, or executables in the pool if absent
+	GasLimit uint64   `json:"gasLimit"` // overrides gas limit of the block if not zero, limited to the range valid for the next block
+}
+
+type PackPreview struct {
+	ParentID     thor.Bytes32 `json:"parentID"`
+	Number       uint32       `json:"number"`
+	Timestamp    uint64       `json:"timestamp"`
+	GasLimit     uint64       `json:"gasLimit"`
+	GasUsed      uint64       `json:"gasUsed"`
+	ReceiptsRoot thor.Bytes32 `json:"receiptsRoot"`
+	Txs          []*PreviewTx `json:"txs"`     // included txs in order
+	Skipped      []*SkippedTx `json:"skipped"` // txs not included, with reasons
+}
+
+type PreviewTx struct {
+	ID       thor.Bytes32 `json:"id"`
+	Origin   thor.Address `json:"origin"`
+	Gas      uint64       `json:"gas"`
+	GasUsed  uint64       `json:"gasUsed"`
+	Reverted bool         `json:"reverted"`
+}
+
+type SkippedTx struct {
+	ID     thor.Bytes32 `json:"id"`
+	Reason string       `json:"reason"`
+}
@@ -236,7 +236,7 @@ func swaggerUiSwaggerUiJs() (*asset, error) {
 	return a, nil
 }
 
-var _thorYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6b\x93\xdb\xb8\xb1\xe8\xf7\xf9\x15\x28\xe7\xd6\x95\x37\x65\x6b\x40\xf0\x05\xce\xb7\xdd\xb5\x73\x32\x95\x3d\xb1\xaf\xd7\x37\x39\x55\xa7\x4e\x5d\xe1\xd1\x90\x18\x53\xa4\x42\x42\x33\x9a\xbb\x39\xff\xfd\x14\x00\x92\x22\x25\x8a\x92\x66\x34\x9b\xf1\xc6\xda\xaa\xad\x31\x89\x47\xa3\xbb\xd1\x2f\x34\x9a\xc5\x0a\x72\xb6\x4a\x6f\x90\x3f\xc5\x53\xef\x2a\xcd\x55\x71\x73\x85\x90\x4e\x75\x06\x37\xe8\xf3\xa2\x28\xa1\xd2\x57\x08\x49\xa8\x44\x99\xae\x74\x5a\xe4\x37\xe8\x1f\x57\x08\x21\xf4\xe9\xfd\xcf\x9f\xd5\x3a\x43\xdf\x7f\xbc\x45\xba\x40\x4c\x08\xa8\x2a\xf4\x17\xf8\x71\xc1\xd2\xdc\x76\x45\x7f\x06\x7d\x5f\x94\x5f\xae\x6c\xfb\xff\xfc\x58\x16\x7f\x03\xa1\xd1\x1f\x8b\x25\xfc\xd7\xeb\x85\xd6\xab\xea\xe6\xfa\x7a\x9e\xea\xc5\x9a\x4f\x45\xb1\xbc\xbe\x03\x61\xfa\x5e\xeb\x45\x51\x7e\x77\x85\x50\x96\x0a\xc8\x2b\xb8\xb1\xdd\x73\xb6\x84\x1b\xf4\xd3\xbf\x7d\xfc\xc9\xc0\x6a\x1f\xad\xcb\xec\x06\x4d\x9a\x81\xee\xef\xef\xa7\xf3\x7c\x3d\x2d\xca\xf9\x75\xdd\xb3\xba\xce\xe6\xab\xec\xad\x59\x1b\xe4\xd3\x85\x5e\x66\x93\x2b\x84\xee\xa0\xac\xec\x3a\xbc\xa9\x3f\x25\x57\x57\x15\x94\xe6\x91\x99\xe6\x6d\x3d\xe6\xf5\xc4\x4e\xd0\x5b\x75\x56\x08\x96\x21\x03\x1b\xca\x0b\x09\x57\x57\x9a\xcd\xeb\x4e\x0e\xb6\xef\x85\x28\xd6\xb9\xae\xf6\xbb\x7e\xef\x70\xe3\xb0\x64\xda\xa0\x82\x1b\x54\x54\x9d\xde\x9f\x4b\x96\x57\x4c\x98\x0e\xa3\x23\xe8\x7e\xbb\xa6\xfb\x0f\x59\x21\xbe\x8c\x76\xe4\x4d\x8b\xa6\xcb\x4f\xc5\x7c\xb4\x03\xdc\x41\xae\xd1\xff\x76\x33\x2a\x28\x51\xe6\x3a\x34\xfd\xff\x6c\xb0\x30\xd2\xdf\x60\x09\x55\x9a\xe9\x75\x85\x0c\x63\x75\xba\xfe\xbc\xe6\x6d\x97\x01\x18\xea\xd7\x1c\x50\x9a\x6b\x30\x2c\x08\x12\x55\xeb\x3d\x9c\xbd\x03\xbe\x9e\xef\x77\xb7\x8f\xd1\x5a\xa7\x59\xaa\x53\x70\xe3\x5f\xad\x98\x5e\x58\x72\x5d\xd7\x34\xa8\xae\x7f\x61\x52\x96\x50\x55\xff\xed\x38\x6c\xc5\x4a\xb6\x04\x5d\xb3\x82\xf9\xbd\x45\xff\xab\x04\x75\x83\x26\xbf\xbb\x16\xc5\x72\x55\xe4\x60\xba\x6d\xdb\x5d\x7f\xef\x06\xb8\xcd\x3f\x32\xbd\x98\x9c\xda\xeb\x13\xdc\xa5\x86\x03\x6f\xf3\xff\xb3\x86\xf2\xc1\xf5\x9b\x83\x6e\xa6\x6d\x18\xab\x19\xae\xc7\x58\x08\x55\xeb\xe5\x92\x95\x0f\x37\xe8\x13\xe8\x32\x85\x3b\x68\xb9\x4a\x82\x66\x69\x56\x37\x1b\xd8\xb2\xe6\x97\xe6\x22\x5b\x4b\xa8\xd0\x8c\xb3\x8c\xe5\x02\x66\x6f\xd0\x0c\x72\x28\xe7\x0f\x33\xc4\x72\x89\x66\x0b\x56\xfd\x58\x48\xf3\x9c\x3f\xb4\x43\xcf\x6a\x5c\xcd\xa6\xe8\xfb\xbc\x7d\x7a\x9f\xea\xc5\xb6\x03\xe2\x80\x7e\xaf\xcb\x35\xfc\x1e\xa5\x15\x62\x48\x14\xb9\x2e\x99\xd0\xd3\xab\x76\xf6\x3f\xa6\x95\x2e\xca\xd4\xec\xa4\x3e\xd0\x48\xb0\xdc\xf4\xff\xfb\x1a\xca\x14\xa4\x99\xba\x5a\x81\x48\xd5\x43\x9a\xcf\xd1\xac\xac\x51\x36\xb3\x0d\x1e\x50\xa5\xcb\x34\x9f\x4f\xeb\x71\x4b\xa8\x56\x85\xd9\xef\x5b\xac\x4d\x08\xc6\x93\xed\x3f\x77\xd0\xf1\xe1\x4f\x9d\x37\x06\x4c\xc8\x75\xb7\x31\x42\x6c\xb5\xca\x52\xc1\x4c\xf3\xeb\xbf\x55\x45\xde\x7f\x8b\x50\x25\x16\xb0\x64\xbb\x4f\xd1\x20\xe9\x5d\xdb\xea\xba\xa6\xe3\xc4\xa1\x63\x55\x54\xed\x9c\x12\x56\x25\x08\xa6\x41\xde\x20\x83\xc0\x33\x19\xe1\xfd\x06\xc4\x5a\x6f\xf9\x40\x34\x3b\xf3\x20\x17\xe8\x02\x55\xe9\x72\x9d\x31\x0d\x2d\x99\xd0\x12\xf4\xa2\x90\x48\xb0\x2c\x7b\x63\x49\x5b\xac\x35\xaa\x20\x97\x86\x04\x1d\xb9\xd3\x4a\x13\x64\xe5\xf5\xb4\x1d\xb5\xfd\xe3\x56\x4f\x2a\xb4\xae\xc0\xe8\x07\x23\x49\x2a\x9d\x2e\xcd\x54\x73\x66\x1e\xb3\x39\x58\x4e\x03\x0b\xb6\x19\xb0\x84\x6a\x9d\x69\x54\x28\xc3\x35\x19\x5b\x57\xb0\x25\xed\xdf\xd7\x50\xe9\x1f\x0a\xf9\xb0\xc5\x44\x6f\x51\xac\x9c\xaf\x97\x06\xcf\x6e\xcc\xfc\x2e\x2d\x8b\xdc\x3c\x68\x9b\x9b\x31\xd2\x72\x07\xb7\x83\x74\x1f\xa7\xfa\x30\xcd\xc7\x28\xfe\x23\xcb\xb2\x77\x4c\xb3\xc9\xd7\xc5\xa8\x06\xec\x4f\x96\x24\x93\x9e\xc0\xfc\xfd\xcd\x1e\xe7\xee\x0b\xcd\xc7\x0a\xc0\x47\xb0\x3b\xe2\x4c\x8b\x85\x61\x1b\xc3\xf1\xd5\xe9\x2c\xbf\xe5\x3c\xcb\x72\x1d\xde\xfe\x6d\xf0\xdd\x0f\x06\x2f\x5f\x29\xf3\xb5\xb0\x37\x1c\xd8\x65\xc1\x7d\x06\x3c\x20\x3a\xff\x99\x7c\xc9\x1f\x34\x9c\xc9\x90\xad\x0c\x96\xb0\xca\x8a\x07\xc3\x46\xbf\x86\x04\x1e\x9a\xf6\xb0\x2c\xee\x0c\xff\xbb\xdf\xfd\x0e\x7d\xbe\xfd\xf8\x73\x97\xb4\x6f\xd1\x4c\x32\xcd\x66\x28\xcd\x9b\xed\x83\x78\x21\x1f\x8c\x31\xa0\x17\x1d\xb4\xd4\x63\xd7\x73\x1f\x1c\xc1\x71\x6b\x6f\x88\x72\x9d\xeb\x74\xd9\x1d\x8a\x55\x55\x3a\xcf\x41\x76\x8d\xeb\xfb\x45\x2a\x16\xb6\x7d\xbb\x3e\x83\x2f\xa8\x57\x09\xf2\x37\xb1\xc7\xbf\xd2\xed\x7d\x68\x67\x6f\xad\xf1\x6b\x43\xd9\xdf\x8a\x49\x7e\xdc\x14\x4b\x15\x62\xf9\xc3\x14\xfd\x11\x4a\xa8\x99\x56\x82\x61\xf8\x3d\x66\xff\xca\xcc\x5d\xe3\x13\x1c\xa4\xb1\x71\x03\xd8\x1c\xae\x7f\xf9\x02\x0f\xbf\xb6\xff\xf5\xb3\x9b\xfb\x4f\xf0\xf0\x52\xb8\xa4\xc6\x06\xba\x63\xd9\xfa\x08\xbb\xa8\xa2\x44\xf3\xf4\x0e\x72\xf4\x05\x1e\xbe\x32\x8e\xa8\x11\xef\x98\xa2\x1b\xc8\xb8\xfe\x25\x95\x8f\xe7\x82\xcf\x9b\xdb\x77\xe7\x52\x92\xdd\xef\x28\xf9\xa3\x5d\xfe\x08\x4c\x9e\xdb\xe7\xa3\x53\xdd\xa7\xf2\xcb\x5e\x0c\x68\x88\x67\x3a\x78\x1b\xe7\x14\xfe\x80\x6e\xdf\x4d\xd1\x5f\x17\x90\xa3\xd9\xca\x41\x32\xb3\x9a\xb4\x5c\xc3\x1b\xc4\xd0\xaa\x31\x2c\x36\xce\x91\xcf\xd7\x59\x86\x66\x4b\x30\x1a\x78\x99\xce\x17\xda\xe8\xcc\x12\xf4\xba\xcc\x41\xbe\x40\x56\x2b\x72\xf8\xa0\xf6\x1f\x1b\x4c\xb2\x2c\x1b\x7e\x75\x88\x68\x0d\x8b\x7e\xde\x4c\x06\x7b\xad\xca\x62\x05\xa5\x4e\xbb\xeb\xee\xff\x0c\xde\x0e\xbd\xeb\xda\x09\x8a\x65\x15\x1c\x6c\x37\x0e\xdb\xbf\xc3\x56\xdf\x5f\x68\xc1\x9f\xd8\xfd\xd7\xb9\xe6\x1d\x36\x2b\xd9\xfd\xc0\xd6\xd8\xfe\x60\xc3\x96\xab\x0c\x86\xa0\x4d\xe5\x0d\x9a\xe0\x4d\x20\x21\xf6\x14\x91\x21\xa5\x8c\x51\xe6\x01\xc3\x58\x01\xf5\x3d\x22\x13\x92\x44\x91\x64\x01\x09\x64\x92\xf8\x09\x0b\x3d\x4f\x09\xcc\x81\x7a\x10\x85\x8a\xc9\x90\x30\x45\x87\x80\xb4\xe6\xf9\x67\x36\xbf\x41\xde\xc0\x5b\x6b\xc2\x7f\xb2\x8b\xc7\x1b\xec\x7e\x5e\x33\xf6\xd0\x70\xb0\x59\xa5\x25\x73\x0b\xf6\xf1\xd0\x7c\xd6\x60\xaf\x6e\xd0\x7f\xfe\xd7\xc0\xdb\x39\xab\x3e\x96\xa9\x80\x1f\x0b\x33\xa7\x47\xe8\x70\x9b\x1b\x44\x3c\x8c\x87\x86\x2f\xca\x74\x9e\xe6\x16\xdc\x38\x8c\x62\x49\x7d\x1e\x73\x2a\x29\x66\x52\x0a\x4e\xa8\xc7\x62\x4f\x86\x81\x12\x31\xf7\xfd\x28\x50\x0a\xe4\xd0\x32\x24\x64\x30\x67\xba\x28\x6f\xac\xcc\x19\x68\x91\x17\xb9\x00\x3b\xcf\x2e\xee\x87\xc7\x33\xa2\xac\xfa\x90\x1f\x1c\xaf\x4a\xff\x3f\xdc\x20\x8f\x0e\x2d\xea\x30\x13\x5b\xfa\xdc\xbe\xeb\x91\x47\x04\x21\x4d\x82\x24\xa1\x21\x8b\x24\x8d\x78\xec\xf9\x49\x94\x60\x4e\xa9\xe7\x49\xe9\xf3\x20\x0a\x62\x81\x89\x0c\x54\xe0\x09\x09\x8a\xc7\xd2\x27\x3e\x89\x87\x59\xd8\xce\xf0\xe7\xf5\x92\x43\x39\xcc\x22\x75\x93\xcf\xe9\x12\x2a\xcd\x96\xab\x1b\xe4\x85\xc4\xf7\xc2\x88\xc4\xde\xb0\x1a\xbd\x2e\x41\x40\xba\xd2\xbf\xa6\x3a\xdd\xd3\x8d\x17\x54\x72\xa8\x5e\xcf\x29\xca\xee\xe5\xe9\xa8\x83\x72\xf9\x88\x54\x76\x6b\xde\x67\x9a\x8e\x4c\xee\x3e\x3e\x8b\xad\x4f\x98\xd8\x09\xdd\x5d\xfe\xda\x8f\xbe\x9c\x43\xdc\x1f\x8b\xe5\x32\xd5\xa7\xdb\x2f\x69\x6e\x84\xfa\x68\x40\xee\x9f\xe7\x7d\xf7\xd4\xe6\x0b\xe2\xb7\x51\x5d\xfa\x1f\xb7\xef\x1c\x51\xdd\x59\xe0\xf5\x2f\xcd\xb1\xca\xe3\x6d\xef\xad\x4b\x74\x96\xc0\x78\xbf\x59\xb1\x5c\xc2\xc9\x42\xa3\x73\xbc\x39\x24\x2e\xec\x7a\x4e\x10\x10\xa8\x28\x51\x6e\xa5\xed\x1b\xf3\xe7\x84\x43\xa5\x27\xd6\xa5\xca\x98\xb6\xf1\x2a\x33\xd0\x14\xdd\x2a\x34\x83\x1a\xc4\xe6\xc8\xa9\xb0\x43\x76\xec\xe7\x2c\xeb\x1d\xc6\x22\x96\x15\xf9\xdc\x5a\xd2\xed\xa4\x7a\x01\x69\xd9\x08\xb0\x0a\xdd\xa7\x59\x66\x6c\x6a\x58\x72\x90\x12\x24\x5a\xe7\x12\x4a\x34\xeb\x0e\x33\x43\x2a\x85\x4c\xa2\x34\xaf\x34\x30\x89\x0a\x85\x52\xf9\x12\x5d\xff\xe7\xb0\xbe\x2d\x99\x87\x35\xe5\x78\xc7\xdb\xea\x73\xb9\xce\x0f\x74\x3d\x6e\xc7\xee\x0b\xb9\xa1\x5f\x0f\xb1\x5d\x0d\x75\xfb\xae\x3a\xd4\x67\x50\x34\xd7\x93\x3e\xac\xe0\x06\xb1\xb2\x64\x0f\x07\xdb\xa4\x1a\x96\x23\x10\x35\x83\xb8\xe3\xd0\x91\x66\x8d\xf5\x6b\x2c\x19\x42\x03\xce\x59\x88\x41\xc5\x71\x4c\x69\xa2\x94\xc7\xfc\x28\x06\x89\xb9\x4f\x65\x08\x61\x44\xa2\xd8\x0b\x82\x38\x16\x01\x96\xe0\x53\x19\x7b\x02\xa4\x8c\x54\xa2\x58\x10\x0f\x5a\x32\xff\x1a\x34\x6f\xf7\xed\x81\x7d\xbf\xb3\xdf\x9f\x97\xf2\x23\x08\x6f\x7e\x8f\x73\x75\x4f\xeb\x7d\xd0\x42\x41\x7b\x58\xab\x05\x69\x2d\xa5\x77\x3a\x1c\xf4\xcb\xf2\xda\x2a\xf6\x49\xe8\x93\x60\xef\x75\xed\xb4\x61\x8c\x03\x15\x09\x41\x29\xe7\x41\x44\x22\x96\x90\x04\xc7\xb1\x47\x81\x12\x45\xc2\x90\x53\x65\xbc\xb5\x20\xf4\x59\x4c\x81\xc6\x49\x0c\x9c\x0a\x60\xbe\x9f\xf8\x9c\x78\xe1\x3e\xfc\xce\x55\xf0\x63\x7f\xdf\xf6\x62\x25\xe4\x7a\xeb\x0f\x98\x89\x79\xec\x63\xc9\x65\x82\x15\x48\x9c\x48\x2f\x0a\xb9\x92\xca\xf7\x85\xc0\x00\x32\x88\x41\xe0\x88\x26\x3e\x55\x11\x40\xcc\x63\xe1\x11\x16\x00\x4b\xe8\x80\x5f\xa4\xbb\x36\xbe\xef\x93\x28\x4e\x06\x9c\xb0\x39\xab\x7e\x4a\x97\xa9\xbe\x41\x9e\x47\x42\x3f\x8c\x93\xbd\x26\x1c\x72\x50\xa9\x48\xad\x8e\x9c\xe0\x0d\x0f\x70\x12\x08\x12\x2a\x1a\xc9\x88\x50\x25\x65\x18\x7b\x4c\x89\x00\xc7\xb1\xc2\x12\x7b\x49\xc4\x14\x0f\x06\x1c\xd8\x39\xab\xfe\x6f\x65\xcc\xab\x61\x87\x50\x17\x9a\x65\x3f\x8b\xa2\x34\xbe\x15\x26\x49\x42\xf7\x3d\x4a\xbd\xa9\x3e\x15\x85\xb6\x80\xd0\x44\x2a\x99\x28\x21\x3d\x2c\x12\x08\x7d\x19\xd1\x30\x21\x42\x51\x1e\x06\x98\x13\x8a\x79\x4c\xa4\x4f\x3d\x4e\x23\x1a\x12\x9f\x10\x3f\x49\x88\xf2\x01\x27\x8c\xe2\x88\xf3\x01\x9c\x6d\xaa\x3f\x00\xd3\xeb\xd2\xd8\xc3\xfb\x00\x56\x9a\x69\xd8\x4e\x1f\x71\x21\x22\x49\xbc\x80\x8b\x44\x52\x89\x25\x48\xce\x3c\xec\x11\x16\xf9\x82\xfa\x5e\x2c\xbd\x44\x40\x12\xab\x08\x0b\xca\x08\xa8\x50\x84\x09\xe7\x32\xc0\x32\x20\x91\xb7\x3f\x7d\xb3\xd3\xdb\x29\xbc\x30\xa6\x31\x90\xd0\xf7\x45\x10\x63\xa0\x2c\xa2\x14\x22\x21\xbd\x98\x79\x00\x1e\x91\x34\x08\x8d\xd4\x95\xa1\xa2\x44\x12\xe1\xe1\x04\x88\x8c\x08\x89\x24\x85\x30\x18\x70\xfa\xed\xf1\x56\x69\x07\x67\x3c\xe6\x24\x56\x22\x81\x58\x92\x44\x25\x8a\x40\xc8\xa5\x1f\x79\x71\x10\xb3\x30\xf4\x42\x89\x85\x20\x72\x00\xce\xd4\x89\xca\x1d\x33\xb9\x45\xe2\x11\x49\xf8\xf6\x32\x5a\xc3\x18\x9e\x59\x31\xaf\xae\x6d\x62\xd9\x71\x5f\xa2\xcd\x4f\xeb\x58\x7c\x7f\x48\x33\x0d\x65\x9d\x9a\x96\x6d\x1b\x1c\x30\xfa\xde\xb7\xed\x10\x2b\xc1\x28\x05\xb9\x16\x2e\xbb\x68\xf6\xe1\xe3\xff\xfb\xe9\xc3\xbf\xd9\xb3\xc6\xf7\x7f\xf9\xf7\x17\xea\x66\xd8\x05\xb8\x45\xbf\x40\x67\x63\x4c\x8f\x1d\xd4\x5f\x8f\x36\x14\x2c\x2e\x86\xf4\xcd\x31\x5d\x3f\x16\xa5\x1c\x9b\xf0\xa7\x62\xbe\xf5\x83\x2d\xe7\x36\xa9\x90\x4f\x62\xde\xdd\x7c\x4a\x74\x98\x7f\x3f\x77\x9b\x5a\x16\x2e\x41\x14\xa5\x51\xa6\x45\x8e\xfe\xf2\xfe\x73\x3b\x58\x3f\x1d\xee\x45\xf1\x70\xb3\x88\x6f\x6c\xdc\x43\xc7\x3f\x8d\x93\xf3\x42\xc2\x75\xee\x52\xb3\xaf\x57\xd0\x7a\xfb\x23\xee\xf7\x9f\xb7\xa7\xd8\xfb\xce\xb7\x28\xf2\x1c\x84\x06\x89\xec\x60\x2f\x8f\xbe\x07\x69\x38\x86\xb2\x8f\x00\xe5\xcf\x9a\xe9\xca\x21\xad\xea\x66\x2c\xbb\xf8\xc9\x51\xac\xed\x67\x39\x77\xd0\xf7\xfa\xaf\xc0\xab\x42\x7c\x01\xfd\x5d\x27\xdf\x39\x87\xfb\x6d\xa2\x36\x7a\x6c\x1e\xd3\xc7\xa2\x4a\xf5\x7e\x1e\xd3\xcb\xa3\xcc\x23\xa3\xa3\x07\x5d\xc6\xf1\x6e\x1f\x78\x55\x64\xa0\x07\x8c\xac\xf1\x7d\x77\xdc\x53\x1c\x8b\x0c\x0c\x76\x38\xe6\x01\x8e\x7a\x7f\x27\xf8\xfc\x97\xf5\xf7\xf7\x37\x40\xc7\x84\xbb\xfc\x06\xb0\x83\x1f\xd1\x8c\x2e\xc7\xab\x62\x3a\xad\xd4\x03\x12\x65\xaa\xa1\x4c\x99\x31\xe6\x6c\xb4\x6e\x2f\x65\xed\x92\xfb\x68\x7b\x01\x81\x49\x59\x76\x28\x90\xe6\x37\x6e\xfa\xce\xb3\x21\xd6\x3f\x40\xc0\x7e\xea\x99\x4b\x6d\x41\x85\xaa\xad\x5d\x58\xa6\x5a\x43\xb9\x07\x83\xc6\xcf\x04\x81\x2e\x56\xa9\xc0\x2d\x00\xfb\x13\x7b\xcf\x39\xb1\x37\x32\x31\x79\xce\x89\xc9\xc8\xc4\xfe\x73\x4e\xec\x8f\x4c\x1c\x3c\xe7\xc4\xc1\xee\xc4\x5f\xbf\x86\x38\xe8\x2b\x3c\x8f\x86\x78\xdc\x59\x5b\x6b\x95\xed\x76\xba\xea\xfd\xb9\x23\x7a\xfb\x3e\xc8\xe5\xa5\x6f\x33\xfe\x65\x04\xf0\xf3\xc8\x5d\xbd\xf9\x60\x33\x11\x9e\x69\x57\xb8\x98\x4b\x57\x04\xeb\x4d\xbd\x60\xc3\xdc\x2c\xcd\x5d\x3a\x72\x83\xaa\x3d\xf8\x2a\xc8\x25\xfc\x0a\x9a\x41\x17\x5f\x20\xdf\x9d\xad\x01\xa2\x04\x91\xae\xd2\xae\x38\x79\x66\x38\x76\x27\xfc\x1a\xc4\xc8\x53\xbc\xb5\x17\x2a\x4d\x06\xdc\x15\x60\xcf\x62\xac\x75\xae\x20\x4c\x2a\x64\x66\x39\x49\x68\xd4\x7b\xa8\x19\xdd\x30\xd0\xd6\xef\x79\x63\x53\xed\x79\x56\x14\x4b\xa4\x6c\xc4\xc0\xec\x35\xa6\x91\x5d\x72\x95\x1a\x3f\xd3\x9e\xb5\x30\xa5\x9c\xd7\x59\xf3\xe1\x36\x3f\xfa\x92\x32\xe7\xb7\xc0\xc3\x3f\x00\x3b\x70\x4e\x73\x2a\xff\x1a\x96\x92\xc0\xd7\x73\xa3\x7d\x44\x8b\xd8\xb1\x00\xd8\xf6\x6e\x6e\x37\x05\xa4\x04\x66\x2f\x6e\xb9\x61\x06\x98\xa5\x1d\x41\x15\x65\x7b\x01\xa5\xa5\xc5\x4b\x8b\x6b\x09\x28\x3f\x58\xb8\x27\xae\xf9\xd5\xcb\xe3\x1a\x27\xc9\xdd\x7d\xf3\x0e\x1d\xeb\x8c\xf3\xb7\x25\xcb\xe7\xf0\x48\x6a\xb6\x11\xa0\x26\x7d\xdd\x0e\x36\x2e\x01\x8c\xb2\x58\x40\xff\x02\xb1\x4b\x67\xaf\xb7\xf1\xcb\xa4\x75\x9d\xb9\xfe\xc9\x2c\xb0\xa6\xf8\xcb\x23\xf5\xa9\x0b\x98\x5c\x5d\x6d\x5b\x98\x61\xea\x46\x6e\xc4\xfa\xd2\x42\x33\xfc\x90\xaa\xaa\x6f\x8e\x77\x21\x38\xc5\x60\xa8\xbb\x19\x1b\x71\x9d\xa7\x1a\xfd\xf5\xfd\xed\x1b\xb4\x2a\xa1\x82\xbc\x95\xea\x0b\xd8\xec\x8f\xd2\x8d\x66\x04\xb1\x52\x9e\x4a\xb0\x4f\x62\xc6\xb0\xa2\x1d\xed\xea\x6e\xb1\x9f\x0b\x95\xeb\x65\x81\x4a\xf3\x47\x02\x25\x54\x44\x02\x2f\xa4\x32\x4c\x3c\x3f\xe9\x1c\xa4\xd6\x57\xe3\xf7\x61\xe2\x45\x91\x01\xcb\x0f\x01\x75\xbf\x00\xbd\x80\xb2\xb7\x57\x16\xac\xea\x5e\x27\xea\xc1\xe0\x12\xac\xed\x9b\xee\x7c\x43\xc4\x13\x83\xf0\x8c\x2e\x2f\xc2\xe6\xbf\x00\x87\x24\xc2\x18\x53\xac\x24\xc6\xcc\x8b\xc2\x88\xc4\x2c\x66\x31\xf1\x71\x48\x09\x16\xc4\x97\x3e\x03\x22\x05\x8d\x98\xf4\x7c\x1c\x46\x1e\x23\x94\x24\x92\xc6\x22\x16\x9c\x06\x7e\xe8\x47\x61\x90\x10\x2e\xbd\x30\xa0\xc0\x63\x88\x95\xc0\xca\x8f\x7c\xc2\x21\xc1\x98\x24\xf5\xdd\xf8\x9a\x5b\xc7\x96\x61\xaf\xca\x9c\xb9\x0e\xfc\xb4\x9f\x57\x43\xe7\x72\xd2\x6f\x86\x04\x5d\x37\xf6\x67\xcc\xb8\xa6\xf0\xc5\xa1\x55\x34\x19\xc6\xe7\xee\x24\x6b\x78\xa5\x12\x72\x9d\xaa\x14\x4a\xf4\x9a\x3f\x68\xa8\x7c\xf2\xdd\xe1\x95\x5f\x28\x4d\xa2\x9b\xb1\xbc\x07\x75\x9a\x6b\x98\xf7\x1c\x1f\x55\x94\x4b\xa6\x6f\xec\xde\xf2\xc9\xf8\x7a\x5c\xc6\x07\x7a\xbd\x80\x74\xbe\xd0\x83\x4b\xd9\x49\x06\xd9\xc9\x8d\x3e\x13\x9e\x28\x18\x87\x67\x9d\xa7\x9b\x6d\x56\xc6\x10\x38\x9d\x3c\x0d\xfb\xba\x93\x3d\x3b\xcc\x1e\x9b\x26\x65\xe0\x1b\x77\xfc\x4b\x71\x47\x3b\xf1\xe6\x7c\x72\x76\x65\xca\x96\xa8\x07\x48\x79\xb9\xe4\xbe\x26\xc6\xf2\x14\x70\xdd\x7d\x11\xf4\xda\x05\x54\x0e\xb1\x9f\xe4\x01\x26\x71\x10\xc7\x9c\x30\xaa\x20\x10\xd4\x17\x91\x64\x0a\x62\x45\xa3\x28\xa6\x9c\x7b\x9c\x32\x2a\x6b\xf1\x5b\x3b\xba\x83\x1b\xcc\x85\xca\x8b\xfe\x21\xfb\xb7\xbd\xb6\xcb\xdb\xdf\xf6\xda\x09\xcc\xfb\x2f\xbe\xd7\x9a\xde\xce\x05\xbf\xcd\x25\x6c\x2e\xc7\x66\xa9\x19\xce\x16\x6e\xb1\xa3\xd7\x81\xa1\xb9\xb1\xc5\x99\x86\x0a\xe9\x45\x5a\x99\xad\x3b\xb4\x8a\x5a\xd7\xfe\xb0\x3d\x83\x1f\xde\xd1\xf9\x0b\xd9\x1a\xa9\x3c\x81\xac\x0d\x08\xb5\xf4\x38\x55\xdc\x3c\xbb\x90\xb1\xd9\xb0\x17\x43\xe1\xa7\x9f\x3e\x22\xc8\x8d\x07\x52\x67\x03\xdb\xf1\x8d\xef\x65\xd7\x3d\x88\xcc\x4e\x22\x6e\x9b\x80\x7b\x31\x7c\xba\x11\x6b\x58\x6e\xdf\x8d\xa3\xf3\x02\xb9\xbe\xfa\x45\x49\xc8\x36\x97\xf8\xc2\xc0\xcc\x59\x85\x32\x33\x30\x7a\xbd\x64\x1b\xc4\xb2\xac\xb8\x07\x69\x1c\xd9\xb5\xad\x77\x93\xde\x75\x0b\xd1\x14\xaa\x97\x61\x31\xb8\xa5\xf6\x72\x9d\xbb\x39\xce\x17\xe3\x86\xce\x89\x46\xe3\x74\xeb\xc2\x59\xec\xcd\x25\x23\x54\xc2\x3d\x2b\xe5\x01\x46\x39\x3f\xd3\xba\xc9\xb0\xbe\x18\x05\x4e\x43\xf2\x10\xfc\xfd\x1c\xef\x4e\x6e\xf7\xc5\x60\xab\xd6\x4b\x8b\xdb\x2c\x43\x2c\x17\x50\xe9\x92\x65\x75\xd8\x7f\x82\x2a\x33\xd7\x20\xed\x77\x32\xcb\x9b\x8c\xf2\x8b\x91\xbd\x2c\x0a\x1b\x5d\x59\xec\x62\xc9\x08\x26\x5b\xb9\xc8\x52\xfe\x00\xcd\x2f\x97\xd4\xde\x4d\x66\xbf\x98\xc8\xad\xd6\xab\x55\x51\x6a\x90\x66\x78\xa4\xea\xf1\x11\x4f\x75\x05\x7a\x58\xbd\x36\x8f\xb6\xd9\xf3\xcf\x83\xea\xa6\x2c\x8c\x9b\xe8\x10\x7a\x2f\x96\xb4\xdf\x4b\xd6\x7f\x76\xe6\x19\xba\x05\xd4\x5d\xd7\xe5\x6e\x0a\xd4\x37\x04\xce\x5c\x11\xc1\x07\x8d\xca\x05\xa0\x22\x37\x36\x59\x81\x9a\xe2\x5a\xc6\x1c\xeb\x5e\xb2\xdc\x5d\xcd\xe9\x57\x13\xec\x00\x3f\x5a\xab\x6f\xcc\x78\xd3\xc5\xb9\xb6\xf0\xa4\x3d\x7e\xde\xda\x95\x6f\x5c\x4d\x13\x55\x94\x83\xa5\xce\x5a\x5f\x6d\x72\x60\x59\x21\xf6\x03\xc6\xc2\x04\x7b\x24\xe4\x51\x80\x89\xcf\x30\x89\x88\xe7\x11\x9e\x50\x19\x13\xf0\x05\x85\x00\x77\x88\x71\x6a\x58\xb2\x07\xfa\x02\x36\x96\x38\xdb\xa3\x74\x57\xaf\xac\x4d\xee\x86\x43\x0a\x27\x88\x95\xe4\xbe\xf0\x55\x10\x46\x02\x63\x8c\xb7\x90\x48\xd6\x3f\x45\x3e\x05\x90\x34\x5f\xad\xb5\xed\x59\xe3\xe6\x90\x1b\xd1\x46\x42\xc7\x68\x78\x92\xe1\xdb\x9f\x7f\xeb\x47\xd7\x07\x45\xc3\x25\x3d\x9e\xc7\x0b\x2b\x1e\xe7\x83\x0d\x6d\x97\x53\x00\x3f\xdf\x15\xdb\xd6\xcd\x78\x04\x8c\x6d\x67\x0b\xe9\x8a\xa5\x0e\x4e\x63\x22\x28\x18\x94\xbe\xbd\x5a\x1a\x97\x75\x04\x0c\x73\x39\xdb\x7f\x9f\xce\xee\xb8\x3f\xad\xba\xde\xc2\xa0\x5d\xd0\xa9\x80\xd2\xd6\x59\x39\x13\x42\x7a\x08\xc0\x8c\x55\xda\x41\x59\x28\xeb\x97\x56\x8d\x04\x3c\xe0\x26\xf8\x49\x3f\x16\xf2\x09\xd4\xb9\x54\xa2\x4e\x32\xa3\x55\x09\x2a\xb5\xde\x71\x55\x2c\xe1\x5c\xe7\xa4\x73\x1e\xb6\xad\x16\x73\x31\xc2\x4d\xb6\x83\xa2\x12\x6a\x33\xb3\xa9\x46\xf9\x09\xd4\x9b\xf6\x74\x8f\xef\x26\x69\xb7\x40\xc7\x1d\xdd\xd3\x14\xac\xd9\x03\x70\x37\x37\x7a\x20\x23\x7a\xb4\xf8\x9c\x1d\xb7\x67\x67\x6f\x4b\xdf\x5c\x8a\x49\x44\x01\xca\x38\x21\x46\x97\xac\x2b\x57\x84\x52\xb0\x4c\xb8\x9a\x9e\x86\xad\x55\x9a\xb3\xcc\xee\xb1\x95\x99\x7d\xdc\xde\x9a\xb3\xb3\xed\xbe\xc3\xb6\xb6\x75\xbc\x96\xae\xd8\xbc\xb2\x10\xd4\x15\xb6\x45\x91\x57\xeb\xa5\x03\x16\xea\x8a\xa5\x56\xbf\x1f\x91\x58\x7d\xf7\x60\x5b\x72\xe7\x62\x96\xd4\xed\xbb\x21\x61\x50\xe4\xdd\x7a\x9e\xeb\xd2\xfa\xeb\xdd\x06\x35\x24\xa8\xc8\xa7\xcd\x12\x8d\xe0\x9a\x1e\x95\x68\xae\xc6\xd0\x71\xf0\x7b\xca\x26\x11\x24\x8c\xc1\x8f\x80\x45\x10\x13\x36\xb9\xaa\x0f\x7e\xd8\xfd\xb8\x2e\x2c\xd9\xfd\x53\xac\x82\x26\x68\x72\x5c\xab\x28\x1a\x25\xd4\xe3\x8c\x62\xcc\x24\x93\x49\x12\x9c\x72\xb4\x19\x07\x91\xa2\x84\xc4\x1e\xa6\x18\x7b\x94\x84\x04\x53\xf3\x97\xc0\x9c\x06\x5e\x10\x27\x44\x24\x81\x9f\x84\x49\x80\x13\xea\x13\x3f\xc1\x18\xa2\x20\xc6\x71\x40\x84\xa4\x71\x0c\x22\x51\x49\x82\x23\x2e\x18\x0e\x43\x0f\x43\x40\x3c\xe5\x73\xec\xf9\x20\x09\xf1\x7c\x12\x40\x1c\x0b\xe6\x61\xe9\x07\x51\xc4\x7d\xc2\x3d\x8a\xb1\x88\x09\x78\x24\xf6\x12\x4e\x3c\x5f\x79\x32\x10\x7e\x8c\x7d\x1c\xfa\x49\x22\x25\x89\x99\x4a\x22\x12\x91\x28\x30\x66\x8d\x5d\xee\xfb\x3b\x18\xcf\x4c\xa8\x3d\xf8\xc7\xe8\xc7\x8e\xf3\xdf\xda\x8a\x8e\xf3\xea\x6b\xa3\x2e\xe7\xd3\x9d\x30\xbc\xae\x6d\xe8\x43\xf6\xd1\xf9\xd5\xb1\x6c\x26\xf6\xe3\xe4\xe0\xc1\xfb\x20\x3d\x4b\xf1\x62\xd5\xcd\x4e\x34\x2c\x2f\x3b\xb9\x33\x37\x7b\xa9\xcf\xc3\x1c\xe0\x92\x61\xcf\x65\x80\x86\xf8\xd6\xf4\xa8\xac\x3c\xb1\x86\xf8\x21\xef\xed\x7c\xdb\xad\xf5\x4e\x9e\x04\x5a\x1d\x8b\x3a\x02\xdd\xf9\x6e\x8b\xd3\x14\x67\x83\xd6\xea\x97\x51\x70\x06\x9c\x14\x27\x34\xbb\x85\xca\x86\xa9\x79\x89\xf0\xd8\x01\x0d\x66\x2c\x02\xf6\xf0\x78\x56\xe9\x04\x09\x5b\x83\xda\x1a\x01\x73\x76\x39\xae\x31\xa3\x3e\x45\x6f\x6c\x29\x64\xe1\x73\xb9\x4e\x87\x22\x12\xc4\x8f\x40\x09\x2e\x38\xf7\x83\xbe\x2f\xe9\x82\x9e\x97\x01\x64\x34\x80\x1a\xc6\x11\x78\x34\x51\xc6\xa4\xdd\x05\xe1\x0e\x4a\x3d\xc4\x0a\x47\x52\xa9\x74\xb9\x06\xb4\x04\xd6\xcd\xd9\xaf\x4d\x87\x7b\x56\xb5\xe3\x0e\x01\xd4\x2f\x5b\x59\xac\xf5\x6a\xad\x1f\x27\xa2\x0f\x27\x7c\x37\xba\xe6\xfb\x7d\xcd\x35\x8a\xe9\x81\x95\xfe\x63\xef\x35\x6a\x4b\x9e\x6f\x75\x5a\xcd\xbf\x6f\x50\xaa\xea\x72\xd3\xa5\xcb\x61\xb4\xb5\x60\xeb\xf3\xb8\xb4\x42\x6c\x60\xb4\xa1\x20\x4a\x2f\x45\x77\x00\x89\x03\x15\x19\xdd\x05\xbf\x43\x4b\x3d\xf3\x22\xf6\xd9\xd7\x80\xda\x0b\x2e\xbf\x02\x00\xdb\xeb\x03\xb6\x63\x53\xb3\xfd\x12\x89\x6d\x63\x92\x78\x24\x7e\xf4\xc4\xb0\x50\x2f\x94\x26\x58\x87\xb4\x97\xf7\x5e\xea\x63\x23\x1b\xa1\x28\xca\xed\x47\x3b\xf6\x9c\xba\xb3\xb1\xc5\xab\x22\x5b\xd7\x5f\x43\xe8\x3b\x66\x66\x49\xe7\xeb\x04\xd7\xab\x55\x0d\xaf\x97\xd5\x7c\xea\x0c\x91\xc6\x40\xdc\x2b\x73\xe4\xc8\x6c\xb5\x02\x60\x1e\x71\x9f\xc5\x51\x30\x10\xc1\xb3\x52\x31\x8a\xc2\xc0\x8f\x68\xe4\x45\x49\x04\x04\x87\x41\x44\x23\x15\x93\x0e\x57\xb9\x92\xfa\x63\x7c\xf5\x18\xc2\xdb\xd8\x96\x15\x7b\xb6\xfb\x21\xc5\x81\xfd\x30\x8c\x58\xec\x0b\x0f\x83\x4f\x95\x02\xa2\x84\x31\x40\xb0\x12\x89\x0c\x22\x26\xb1\x17\x50\x85\x63\x20\x51\xe0\xc5\xe0\x79\x31\x97\x1e\x08\x48\x64\x12\x50\xde\x39\x6d\xde\x17\x0c\x17\x09\x06\xec\x88\x81\x41\x01\x70\x91\x89\xf6\x6f\x0b\x5d\xfc\x7c\xcf\x1d\xe9\x81\x44\x72\x6d\x28\x37\xb0\x2b\x0e\x5a\x3c\xe7\xa8\xd0\x03\x3a\xf0\x6e\xf9\xbe\x2c\x4f\x8a\x3f\x6e\x19\xa4\xe6\xd2\xde\x37\x69\x46\x13\x94\x7f\xbd\x90\xd0\x37\x81\x75\x50\x60\x59\xda\xdc\x81\xfc\x6b\x51\x7e\x39\x5b\x6c\x6c\xea\xce\xe8\xbe\x28\xbf\xbc\x76\xb8\xd0\x90\x57\xc6\xe8\x6a\xb4\xc7\x77\x4f\xb6\xc4\x2d\x32\x4c\xc7\xa3\x33\x3c\x47\x24\x54\x6f\x3a\xc3\x1e\x85\xe0\xb1\x31\xe1\x26\xe9\x40\x41\x09\xb9\x80\x23\xf3\xec\x69\x99\x81\xbd\xf4\x16\xe9\xe2\x91\x5e\x22\x3a\x4d\x6f\xa1\x93\x74\x17\xea\x6d\x44\x14\xe2\x5d\xe7\xcc\x6e\x14\x34\xf1\x76\x42\x55\x93\x5d\xd6\x7f\x5c\xbc\xa5\xc3\xdd\x6e\x8e\xc9\x3e\x3f\xda\x55\xfa\x0c\x62\x4a\x08\xe1\xc0\x24\xc7\x3e\x25\xd8\xe7\x40\x3c\x90\xa1\x80\x58\x24\xdc\xe3\x4a\x45\x98\x0c\x86\xdd\x51\x4f\xfe\x0e\x95\x7b\xc7\x34\xf4\x04\x53\xbe\xd8\xf6\xef\x4b\xcb\xbe\x62\xdf\x17\x84\x3b\x42\xf0\xe4\x4f\xef\xd8\x1e\xae\x24\x93\xbb\xd0\x54\x8d\xc9\xe4\x42\xa9\x0a\x4e\xca\x13\x1a\x88\x6b\x8f\xba\x29\x6e\x64\x94\xe6\x68\x69\x96\x0c\xb2\x2e\x71\x85\xba\xe9\x09\xd9\xa9\x59\x4a\x9d\xa4\x91\xd3\xa6\x77\x69\x4a\xd6\x2d\x35\xb3\xda\x0f\x97\x3a\x8b\xe7\x6a\xb4\xef\x8a\xd9\x90\x0c\x54\xd0\xb9\x6e\x6a\x5c\xaa\x87\x62\x8d\x72\x00\x59\x5f\x5e\xb5\xeb\x31\x28\x37\xa2\x6a\x0e\x72\x8a\x60\x3a\x9f\x6e\x79\x7f\x36\x9b\xb5\x7f\xff\xd2\x81\xec\x95\x2b\x37\x5c\xbd\xba\xe9\x3d\x36\x2f\x2c\xc2\x5e\xdd\x20\xfc\xa6\xff\xc2\x2e\xe5\x95\x59\x7a\xbf\x84\xc0\x7f\x5f\xed\xff\xd5\x9d\xd6\x06\x3f\x79\x71\x07\x4e\xcc\xd4\x91\xa6\x95\xcb\x1a\x72\xc4\xa9\x10\x76\xf7\x72\x4d\x5b\xfb\xc6\xe5\xed\x55\xc8\xc3\xd3\x3e\x4e\x6a\xb8\xd1\xcc\xf8\x7d\xb3\x06\x23\xb2\xc8\x27\xda\xe1\x45\x17\x48\xc2\xd2\x0c\xb6\x62\x73\x5b\xb5\xac\xc3\x8a\x9f\xb6\xd7\x11\x87\x19\x71\x9d\x0f\x31\xc2\xbe\xf5\x91\xaf\x97\x7d\xcb\xe0\xed\x5e\xfe\x82\x15\x85\xe9\x12\xae\x86\xf8\x67\xb7\xf1\x08\x0b\x49\x50\x69\x5e\x47\x87\xed\xc9\x57\xa1\xd0\x4c\x95\xc5\xb2\xfe\x9e\xaa\x2e\x66\xd3\x5e\x87\x99\x1d\x7c\x56\x07\x25\xba\x69\xa5\x6f\xd0\xcc\x40\xd4\x7f\xd5\x66\xf5\xbd\x31\x53\xb1\x75\x66\xb3\xd2\xea\x41\xfa\x23\xb7\xff\x30\xd3\x5f\x26\x68\xd6\xdd\x47\x5b\x96\x19\xc8\xce\x78\xcc\xe0\x4e\xb6\x5f\x8d\x6f\xb5\x2e\x7e\xed\x0d\x53\xb3\x7c\xb7\xbb\x50\x9a\xbb\x0d\x75\x7c\x3f\xd9\x9e\xfb\xbb\xc9\x10\xec\xd5\x0d\x7a\x65\xb1\xf9\x6a\x67\x47\x19\x2c\xda\x0d\xb5\xf3\x5c\x17\xaf\x76\x44\xfb\xf1\x5d\xd6\xec\xad\xa2\xb3\x0e\x33\x7e\x4d\x64\x0f\x6f\xbf\xe9\x67\x46\xee\xac\xc8\x6d\xa4\x4a\xb3\x5c\x3a\xbb\xd2\x1e\x2c\xae\xb3\xcc\x8d\x32\xc0\x01\xd6\xdf\xf9\xb1\x2e\xc9\xf1\x0c\xc7\x25\x47\x6b\x13\xb9\xd2\x41\x47\x87\x75\x85\x7e\x4e\x6b\x46\x4e\x6b\xe6\x9f\xd6\x2c\x38\xd2\xec\x00\x2b\xb6\x65\x4e\xb6\x1c\x58\xac\xb5\x43\xc2\x14\x7d\x9f\x65\xae\x78\xbb\x2b\xd5\xf8\xb7\x22\xcd\x9b\x1b\xa4\x33\x96\xcb\x19\x32\x04\x60\xba\x28\xa7\x0d\x51\x5d\xa9\x77\xd3\x38\x9d\xe7\x45\x79\x86\x7a\xa8\x49\x60\x58\x77\xfc\x5e\x63\x10\x46\xef\xa3\x30\x26\x51\x1c\x27\x3d\xfe\x7e\xe5\x88\xe4\x46\x90\x52\x91\x90\x30\xe9\x71\x20\x82\x26\x3c\x4a\x04\xe1\x38\xa2\x4a\xf8\x31\x95\x8c\x25\x21\xe1\x2c\x56\x5e\xe4\x8b\x80\x79\x5e\x44\xa8\x0a\x43\x16\x48\x15\x12\x9f\xfb\xa0\x5e\x1d\xe1\x7e\xa7\xdb\xab\xda\xc1\xaf\xf9\xc5\xd5\x61\xc5\x1b\x08\x13\x19\xc4\x21\xe3\x10\x25\xa1\x88\x55\x14\x33\xca\x88\x4f\x3c\xe5\xfb\x8c\x86\x11\xc7\x3c\x10\xb1\x27\x9d\x3c\x75\xf8\x74\xc0\xcf\x10\xfc\x7d\xcd\xb2\xca\x8c\xf2\xd4\x25\xb4\xa2\x74\xcf\x88\x6e\x76\xc9\x59\xa8\xde\xdd\x0b\xd6\x96\x7c\x22\x88\x93\xdd\x9d\x33\x76\xa9\xf5\x71\xe6\xfd\x56\x7e\x38\x85\x3c\x7e\xa6\xdd\x51\xd6\xe8\x88\xf1\xd9\xd1\xef\x9d\x8c\xab\xbe\xe5\x79\xda\x18\xb5\xb9\x3a\xd9\xdb\x95\x3f\x0f\x59\xa8\x97\x08\x1d\x35\xa2\xb4\x9b\x2a\xb6\x73\xc8\x38\x66\xe1\x9a\xb6\x46\x56\xd6\xe5\x4c\x5a\x35\x6e\xb5\xc1\x8c\x55\x62\xf6\x38\x83\x86\x55\x62\xe7\x89\x81\x62\x9f\x9c\x4d\x54\xea\x14\x8d\x70\xc6\x4d\xa4\x6e\x08\xf0\xd4\x2d\xdc\xc9\x5a\x3d\xf5\x9c\xf6\x69\xd3\x9c\x73\xec\xfa\xb8\x03\xfc\x1e\x8a\xbf\x6d\x9a\x6e\x18\xf4\xeb\xdb\x37\xf6\x7f\x6d\xe9\xd7\x31\x3a\xda\x1a\x5b\xe7\xf0\x94\x5e\x14\xe5\xf5\x9d\x37\xc5\x53\xfc\x36\x8a\x28\xe6\x09\x7d\x2b\xe1\xee\x3a\x4b\xf3\xf5\xe6\x7a\x5e\x78\x53\x0f\x4f\xfd\xce\xf5\x4e\xa8\xf4\x0f\x27\x5f\x4a\xdd\x2d\x73\x40\x63\xee\xb3\x40\x06\x42\x2a\x4f\x88\x90\xc8\x30\xe2\x49\x8c\x03\x15\x08\x8f\x2a\x4c\x30\x78\x3c\xa0\x92\x73\x15\x30\xe2\x4b\x0f\x20\x50\x9e\x62\xa1\x52\x49\xd0\x55\x33\xe7\x5c\x02\x69\x61\x88\x68\x90\xc4\x5b\xb7\x10\xa0\x3c\x73\x0d\x21\x06\x8f\x10\x16\xe2\x10\x20\x0c\x39\x0d\x7c\xdf\xc3\x11\x65\x42\x49\x1a\xc6\xe0\xc7\x4c\x86\x54\x05\x91\xcf\xb0\x62\x3c\x61\x4c\x29\x22\x3c\x08\x38\x01\x22\x09\x61\x10\x7b\x52\x78\x81\x92\x4c\x45\x00\x4c\xc6\x01\x97\xbe\x8a\x70\x98\x04\x51\x10\x30\xe6\x87\x22\xa4\x54\x25\x82\x45\x1c\x7c\x3f\xf0\x80\x08\xf0\xa8\x94\x22\xf0\x7c\x9f\x74\x2e\x0d\xe4\x60\x4f\x66\xcf\x82\xde\x23\x74\xea\x4d\xfd\x64\xea\x11\x7c\xe3\x79\xc4\xef\x9c\x70\xa4\x39\x2f\xd6\xf9\x53\x42\xf0\x72\x7d\x7a\x24\x73\x7b\x10\x40\x6b\x39\xf5\x1f\x5b\x4a\x3c\x2a\x65\x7b\xcf\x38\xba\xe8\xc7\x13\xb7\xff\x6b\x6a\x4f\x8d\x06\xa5\x76\xda\x8c\x21\x73\x44\xce\xa4\xb9\x4c\x85\xbd\x75\xda\xad\xba\x52\xd7\x36\x73\xa5\xca\xec\x57\x52\x17\x69\xe5\xce\x40\x39\x08\x9b\x97\x5b\xb2\x5c\x2c\xba\x5f\x35\xe9\x56\x84\xba\x84\xec\x18\x90\x5d\x01\x7f\xd0\xb0\xf3\x8c\xa7\xf3\x92\x2d\x77\x1e\xf6\xce\x66\xdd\x23\xb8\x5b\xca\xb4\xda\x79\x98\x17\xc5\x6a\xe7\x51\xb1\xb2\x69\x2d\x3b\x4f\x57\x25\xec\xde\xd3\xb1\x11\x94\x72\x68\xf6\x75\xbe\xfb\x74\x84\x00\x06\x1d\xf5\xed\x19\x01\xe5\x14\xbd\x5f\xae\xf4\x83\x7b\xda\xf1\x7a\x9b\xd8\x47\xa5\xcb\xb5\xb0\x1f\x75\x98\xbb\x62\xfa\xa6\xcf\x10\xcf\xbf\xea\xd8\xe0\xac\x9c\x0f\xe9\xb9\x71\x37\xb7\x0f\x65\x1d\xde\x51\x29\x48\xb4\x62\xda\xdd\xf7\xb1\xe3\x6e\x4f\xdb\xc5\xf6\x3b\xb9\xee\xf7\xa3\x4b\x58\xcd\x1e\xde\xa0\x22\xcf\x1e\x3a\xe9\x15\xed\xbd\xac\x29\xfa\x83\x8b\x93\xf4\x3a\xce\xea\x7a\x04\xd7\xaf\xf5\xc6\xde\xbd\xfe\x87\xde\xdc\xca\xef\xae\x3b\xb7\xb1\x67\x87\xc5\xbf\x64\x9c\x07\x32\x52\x98\x19\xdb\x25\x66\x32\x16\x12\x03\x8e\x99\xa7\x08\xe6\x61\x10\x49\x8e\x63\x1f\x4b\x1a\x25\x32\x14\x82\x63\x29\x09\xf3\x22\x88\xc3\x24\xe4\xd7\xf8\x1a\xf7\xeb\xf0\x74\xca\x5e\x3d\x43\x34\xa1\x8f\xe6\xfd\xf4\xaa\x03\xcb\x64\x41\x44\x62\xec\x47\x40\x70\x12\x02\x8f\x3d\x41\xfc\xc0\xc3\x61\x20\x19\x8b\xfc\x30\x8e\x05\x8e\x48\xd0\x2d\xc6\xf4\x05\x1e\x7e\xd6\xac\x3c\xd7\x14\x3c\x9e\x3d\x3b\xfe\x9b\x74\xbc\xe0\x4d\x3f\x9c\xbf\x85\xc0\xc5\xff\x86\x20\xe8\x44\xb2\x4f\x66\xe3\x1d\xf0\x41\x82\xe2\x41\x40\x23\x1a\xaa\x44\xc4\x44\x09\xc2\x93\x20\x4a\x28\x06\x15\x7a\x92\x4a\x82\x29\xe7\x8c\x05\xd2\x57\x52\x28\x2c\xc2\x58\x06\x34\x88\x99\x60\x04\x0e\xb0\xc3\xa8\x7c\x83\x8d\xfe\x13\x9c\x72\x31\x77\xf0\xeb\x4f\xfd\x7b\x27\xfd\x52\x50\xdb\xb1\xea\x92\x76\x47\xc6\x9a\xe0\x8d\xef\x43\x40\xfc\x84\x62\x91\x70\x3f\x96\x38\xa0\x5c\x1a\xbd\xc3\x65\xc0\x08\x03\x9e\x84\x5e\x10\x25\x84\xe0\x20\x0c\x70\xc8\x84\x10\x44\x05\x11\x95\x18\x54\x12\x25\x94\x4e\x76\x93\x7e\xbe\xf4\x97\xd6\x4e\xf4\x44\x3e\xd9\xfb\x6e\xcf\x5e\x26\xd0\x85\x66\x12\xf5\x9e\xf8\x01\xd8\x68\xc6\xc8\xb7\x02\x0a\x17\x28\xa0\xf0\xad\x66\xc1\x41\x2a\x3c\xb2\xaa\xcb\xcb\xba\x24\x6d\x2b\xd5\x9e\x41\xdc\x05\x6c\x4e\xb7\x37\xba\x65\x70\x4f\x28\x80\xfb\x4c\x0a\xec\xdb\xef\xeb\xfe\x75\x2c\xa0\xcb\x6d\x99\x7d\x66\xad\x05\x7b\xa1\xdc\x75\x78\xb5\xce\xeb\x2a\x0a\xc6\x7a\xef\x72\xf2\xa0\xc8\xdf\x3e\x73\xb6\x46\xfd\x75\xcf\x51\x67\xb5\xdf\x04\x9d\xea\xfe\xed\xbb\x79\x69\x85\xec\xbd\x53\xa4\xcd\x80\x06\x80\xdd\x1a\xd2\x75\x7a\xb6\xfb\xcc\x71\x33\xe3\xf6\x23\x20\xdb\xc2\xb5\xa9\x95\xd0\xed\x37\x80\x8f\x5c\x1d\xa8\x5b\x0d\x16\xb6\xdd\x2d\xf3\x3a\x28\x58\x86\xef\xf4\x3f\xee\x32\x88\xed\xfc\x89\xdd\xd7\x25\xb0\xfb\xab\x2c\xd9\x7d\x67\x85\xdd\x1a\xf2\x83\xb8\x2d\x9b\xda\xc0\xcc\xf4\xec\x66\xdd\x4f\xf7\xd6\xdc\x8d\x6f\x0c\x2f\xba\x21\xa8\x83\xb0\xfd\xe4\xf4\x10\x98\xf5\xcb\x53\x60\xad\x6f\x0b\xf6\xcc\x92\xa2\x44\xb7\xef\xa6\x36\xf8\xb6\xe5\x0d\x56\xb9\x1b\x93\xa9\x42\x85\x3b\xb9\x9a\x9e\x42\xa3\x1d\x68\xf7\x39\x67\x00\xd8\x43\xac\xf3\x8f\xae\xc2\xb1\x7a\x7f\xf8\x5b\xd6\x1d\x87\xb9\xfb\x49\xeb\x27\xf2\xd9\x36\x2d\x02\x2a\xed\xd6\xd5\xf9\xe4\x7f\x7f\x51\x0b\x60\xf2\x14\xec\xbb\xeb\x9e\xa6\x75\xf3\xd5\xed\x63\x48\x3f\x19\xe7\xb5\x9f\xf2\x27\x78\xe8\x63\x7d\x0c\xc1\x46\x18\x7c\x81\x87\xd7\xab\xba\x0e\xfc\x77\xc6\xab\x67\x42\x98\xfd\xda\xdc\xf3\xa9\x7d\x91\x31\x64\x3a\x1c\x7c\x81\x87\x47\x20\xf7\x72\x15\x6a\x5d\xcc\xbf\x95\x59\x03\x54\xda\x17\x5a\x07\x09\x35\x78\xe1\x29\x15\x0b\xfb\x29\xf4\xe6\x46\x64\xb5\x93\x03\x70\xce\xee\x7e\x14\x36\x82\x30\x82\xe6\xb0\xb5\xb7\xea\x0f\xa5\x84\x72\x70\xcd\xf6\x40\xe1\x94\x15\x77\xca\x95\x9f\x7c\x06\xf1\xe8\x05\xef\xc7\xf9\x76\x4f\x28\x7a\xe7\x7a\x2d\x7e\x4c\x1b\xfb\xec\xf3\xe6\xf6\xdd\xe9\x7c\x5e\xdf\xb2\xde\xbb\x47\x3c\xc2\xcd\xe9\x49\x3b\x6f\x9f\x7c\x09\x17\x22\x0a\x49\xc4\xe2\x88\x41\x18\x61\x12\x04\xca\x38\xd4\x38\x14\x02\x63\x2f\x89\x63\x12\x44\x82\x27\x44\x10\x1e\x28\x0f\x08\x8f\x19\xc1\x01\x04\xc6\x11\x4f\x80\xf5\xbe\x5b\xb7\xf3\x7d\x86\x3e\x65\x57\xc5\x49\x9c\xbc\xa5\x2b\x43\x15\xbb\x6b\x8b\xd0\xdd\xbe\xb3\x02\xb3\x84\x6a\xbd\x74\xa1\x5e\x40\xdd\x2f\x68\xf4\x44\xd3\xed\xbb\xa7\xaa\x84\xf7\xf5\x07\xb1\x07\x97\xd2\x7c\x2d\xfb\xc0\x7a\x86\xd9\xec\xe0\xe7\x36\xb6\x86\x4e\x09\x7a\x5d\xe6\xed\x92\xd3\xaa\x9d\x69\x1c\xf0\x9e\xea\xfd\x08\xf6\x96\xd8\x30\x0d\xdc\xbb\x8b\xc2\x5d\xd4\x60\x23\xbd\x79\x63\xe5\x0c\x4a\xf5\xa4\xda\x99\x6a\x1c\xee\xff\x09\x00\x00\xff\xff\xbb\xbb\x78\x21\xe2\xa0\x00\x00")
+var _thorYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xed\x7d\x6b\x93\xdb\xc6\xb1\xe8\xf7\xfd\x15\x28\xe5\xde\xbb\x52\x2e\x97\x8b\xf7\x63\xab\xce\x07\xd9\x52\xe2\xad\x38\xf1\x1e\x49\xd7\x39\x55\xae\x94\x38\xc0\x0c\x96\x88\x48\x80\x06\xc0\x7d\xc4\xce\x7f\xbf\xdd\x33\x03\x60\xf0\x5c\x92\xcb\x95\xb5\x8e\x94\x87\x65\x10\x98\xe9\x99\xe9\xee\xe9\x77\x67\x1b\x96\x92\x4d\x72\xa1\x59\x73\x7d\x6e\x9c\x24\x69\x9c\x5d\x9c\x68\x5a\x99\x94\x2b\x76\xa1\x7d\x58\x66\x39\x2b\x4a\x78\x40\x59\x11\xe5\xc9\xa6\x4c\xb2\xf4\x42\xfb\x15\x1e\x68\xda\xbb\xb7\xef\x3f\xc4\xdb\x95\xf6\xfa\xea\x52\x2b\x33\x8d\x44\x11\x2b\x0a\xed\x47\xf6\xed\x92\x24\x29\xff\x54\xfb\x1b\x2b\x6f\xb3\xfc\xd3\x09\x7f\xff\xa7\xab\x3c\xfb\x27\x8b\x4a\xed\xbb\x6c\xcd\xfe\xf1\x72\x59\x96\x9b\xe2\xe2\xfc\xfc\x3a\x29\x97\xdb\x70\x1e\x65\xeb\xf3\x1b\x16\xe1\xb7\xe7\x25\x7c\xfb\x0a\xbe\x59\x25\x11\x4b\x0b\x76\xc1\x3f\x4f\xc9\x1a\x20\xfa\xfe\xcf\x57\xdf\x23\xac\xfc\xd1\x36\x5f\x5d\x68\xa7\xd5\x40\xb7\xb7\xb7\xf3\xeb\x74\x3b\xcf\xf2\xeb\x73\xf9\x65\x71\xbe\xba\xde\xac\xce\x70\x6d\x2c\x9d\x2f\xcb\xf5\xea\x14\x3e\xbc\x61\x79\xc1\xd7\x61\xcc\xad\xb9\x79\x72\x52\xb0\x1c\x1f\xe1\x34\x67\x72\xcc\xf3\x53\x3e\x41\x6b\xd5\xab\x2c\x22\x2b\x0d\x61\xd3\xd2\x8c\xb2\x93\x93\x92\x5c\xcb\x8f\x04\x6c\xaf\xa3\x28\xdb\xa6\x65\xd1\xff\xf4\xb5\xd8\x1b\xb1\x4b\xf8\x8e\x96\x85\xb8\x15\x85\xf2\xf5\x87\x9c\xa4\x05\x89\xf0\x83\xc9\x11\xca\xf6\x7b\xd5\xe7\xdf\x00\x78\x9f\x26\x3f\x0c\xab\x37\xaa\x4f\xbe\xcf\xae\x27\x3f\x60\x37\x0c\x20\xfd\x3f\x62\xc6\x98\xe5\xb0\x03\xd7\xea\xf7\x7f\xc3\x5d\x98\xf8\x1e\x77\x49\x2b\x4a\x52\x6e\x0b\x0d\x11\x4b\x5d\xec\xdd\x55\x96\xad\xfa\x1f\x5f\xa6\xc5\x06\x51\xa4\x5c\x32\x75\xa1\xda\x46\xbc\x5d\x7d\xfe\x7e\x1b\xd6\x1f\x0d\x2c\x41\xfe\x1c\x32\x98\xb6\x64\x88\xc1\x8c\x6a\xc5\xb6\xb7\xe5\x6f\x58\xb8\xbd\xee\x7f\xce\x1f\x6b\xdb\x32\x59\x25\x65\xc2\xc4\xf8\x27\x1b\x52\x2e\xf9\x69\x9f\xcb\x23\x2c\xce\x7f\x21\x94\xc2\xe0\xc5\xbf\x05\x82\x6e\x48\x0e\xa3\x96\x12\x93\xf0\xcf\x99\xf6\xbf\x72\x16\x03\x3a\xfd\xe1\x1c\xd0\x7b\x93\xa5\x0c\x3f\x6b\xde\x3b\x7f\x2d\x06\xb8\x4c\xaf\x60\xf4\xd3\x5d\xbf\x7a\xc7\x6e\x12\x44\xe0\xcb\xf4\xbf\xb7\x2c\xbf\x17\xdf\x5d\xb3\xb2\x9a\xb6\xc2\xcb\x6a\xb8\x16\x5e\x6a\xb0\x11\xeb\x35\xc9\xef\x2f\xb4\x77\xac\xcc\x13\x38\xe4\x1a\x29\x29\x2b\x49\xb2\x92\xaf\x0d\x50\x3c\xfe\x49\xd2\x68\xb5\x85\xdf\xb4\x45\x48\x56\x24\x8d\xd8\x62\xa6\x2d\x58\xca\xf2\xeb\xfb\x85\x46\x52\xaa\x2d\x96\xa4\xf8\x16\x4e\x1e\x9e\x87\xf7\xf5\xd0\x0b\xb9\x57\x8b\xb9\xf6\x3a\xad\x9f\xde\x02\xed\x37\x1f\x68\x70\x60\x7f\x2c\xf3\x2d\xfb\xa3\x96\x14\x1a\xd1\xa2\x2c\x05\x1c\x88\xca\xf9\x49\x3d\xfb\x77\x49\x51\x66\x79\x82\x84\xd8\x06\x5a\x8b\x48\x8a\xdf\xff\x0c\x3b\x92\xc0\x69\xc3\xd4\x88\x49\x49\x7c\x9f\xa4\xd7\xda\x22\x97\x5b\xb6\xe0\x2f\xc0\x6f\xb0\xf2\xf4\x7a\x2e\xc7\x05\xc0\x60\x9b\x81\x5d\x34\xbb\x76\x6a\xea\xfa\x69\xf3\xaf\x9d\xed\xf8\xe1\x2f\xca\x2f\x08\x26\x1c\x91\xfa\xb2\xa6\x91\xcd\x06\x78\x10\xc1\xd7\xcf\xff\x59\xc0\x37\xad\x5f\xe1\x10\xa2\x25\x5b\x93\xee\x53\x6d\xf0\xe8\xc5\xbb\x80\x2d\x62\xc5\xa7\x62\x3b\x36\x59\x51\xcf\x49\xd9\x26\x67\x30\x1b\xa3\x17\x1a\x6e\xe0\x9e\x88\xf0\xf6\x8e\x45\xdb\xb2\xc1\x83\xa8\x22\xec\x51\x2c\x00\xea\x2e\x92\xf5\x76\x05\x53\xd6\xc7\xa4\x01\x7a\x2e\x33\x0a\x27\xb1\x5a\xcd\xf8\xd1\x66\xdb\x52\x2b\x58\x4a\xf1\x08\x54\x6a\xae\x98\x91\xc6\xd9\xfd\xbc\x1e\xb5\xfe\xcb\x65\x79\x5a\x68\xdb\x82\xe1\xf5\x82\x8c\xa8\x28\x93\x35\x4e\x75\x4d\xf0\x31\xb9\x66\x1c\xd3\x18\x07\x1b\x07\x84\x03\xdc\xae\x80\xa9\xc6\x88\x35\x2b\x02\x5f\x36\x47\x0b\x07\x5e\x94\xdf\x64\xf4\xbe\xd9\x89\xd6\xa2\x48\x7e\xbd\x5d\xe3\x3e\x8b\x31\xd3\x9b\x24\xcf\x52\x7c\x50\xbf\x8e\x63\x24\x79\x67\x6f\x07\xcf\x7d\xfa\xd4\x87\xcf\x7c\xea\xc4\xbf\x85\xad\x7c\x43\x4a\x72\xfa\xbc\x10\x15\xc1\x7e\xc7\x8f\xe4\xb4\xc5\x30\xff\x78\xd1\xc3\xdc\x3e\xd3\x3c\x94\x01\x1e\x80\xee\x5a\x48\xca\x68\x89\x68\x83\x18\x5f\xec\x8e\xf2\x0d\xe6\x71\x94\x53\x70\xfb\xf7\x81\x77\xdf\xe0\xbe\x3c\x53\xe4\xab\x61\xaf\x30\x50\x45\xc1\x8b\x5d\x59\xe7\x6f\x89\x97\xe1\x7d\xc9\xf6\x44\xc8\x9a\x07\xc3\x72\x56\xd9\x3d\xa2\xd1\xe7\xe0\xc0\x43\xd3\x8e\xf3\x62\x65\xf8\x3f\xfc\xe1\x0f\xda\x87\xcb\xab\xf7\xea\xd1\x9e\x69\x0b\x0a\xe8\xb6\x00\x11\xa3\x22\x1f\x2d\x04\xfa\x41\x61\x00\xe5\xc1\x7a\x5b\xe4\xd8\x72\xee\xd1\x11\x04\xb6\xb6\x86\xc8\x61\xdb\x93\xb5\x3a\x14\x29\x8a\xe4\x3a\x05\x81\x41\x91\xcd\x6f\x97\x09\x70\x05\x7c\xbf\x5e\x1f\xee\x17\x93\xab\x64\xf4\xeb\xdd\xf2\x65\xdc\x2d\xc3\xd2\xf8\xf9\x92\x0b\x89\xf7\xc7\x96\xca\x85\xce\x10\xe7\xd9\x5a\x11\x86\x2f\x84\x40\x39\x7c\xfc\x88\x42\x71\x92\x23\x1e\x73\x62\x4b\xb7\xeb\x10\xd4\x28\x40\x5f\x8e\x8c\x24\xbd\x66\x33\xf8\x22\x26\xb0\x1a\xae\x31\xe9\x27\xe3\x5b\x53\xde\x6f\x60\x7a\x54\x68\xae\x59\xae\x3c\x8f\xb3\x1c\x28\xf3\x42\xdb\xc2\x4f\x96\xd9\x81\xb6\xcc\xf6\x81\x75\x45\x76\x07\x95\x53\x24\xeb\xbc\x7f\x6c\xf0\x41\x71\xdb\xec\xba\x80\x82\xac\x37\xab\x46\x86\x45\xbd\x93\xa1\x0a\x0b\xd2\xfe\x02\xc7\x59\x48\x05\xb8\xbd\x0c\xe3\xd8\x20\x4b\xad\xe8\xdb\x25\x6e\x19\xdd\x15\xf8\x24\xe6\xf4\x3f\xd3\xb2\x74\x75\x2f\x01\x15\xda\xd1\x8f\x6f\x3f\xd4\x0a\x38\xf0\x09\x8e\x7f\x5a\x96\x57\x47\x50\x2d\x97\xe4\x70\x4a\xac\xdc\xe6\xc0\xcb\x66\xd5\x82\x81\xeb\x01\x73\xcb\x72\x05\x8e\xb1\x55\x86\xa0\x60\x33\x92\x1e\x4d\x95\x94\x34\xf8\x58\x5d\x12\x99\xf4\x77\xa4\x58\x2e\x2a\x4c\x94\xe3\xcf\xe4\x71\x53\x78\x90\x67\x85\xbc\x20\x38\x26\x72\x5c\xad\x5e\xe7\x18\x2a\xef\xb8\x07\x2e\x1f\xe0\x02\xb0\x04\x79\xb9\x6c\xf8\x0d\x07\x7b\xba\x4a\xd6\x49\x29\xf4\x49\x1c\x0f\x4d\x1a\x70\x31\x2e\xce\xce\xc8\x26\x39\x0b\x49\xf4\x09\xef\x07\x76\xc6\x5f\x03\xe8\xe1\xb6\xd3\x16\x29\xbb\x2b\x01\x7e\x78\x0d\x0f\x6b\x81\x47\x25\xb4\x4e\x3e\x02\xfc\xc8\x87\x6f\xdf\x5b\x6d\xb4\x59\x68\x6b\xb4\x9d\xa0\xc5\xa9\x04\x90\x24\x3e\x00\x0c\x2a\x36\x70\x73\x0c\x6c\x44\xa6\x25\x78\x59\xa7\x19\x60\xc1\x0d\xa8\xc2\x24\x04\x32\x00\x84\xc2\x9f\xf9\x1a\x68\x52\xe0\x33\x3a\x87\x5b\x1d\xbf\xc6\xb1\x70\x20\x39\x27\xc7\xb9\x59\x8d\x74\x4b\x96\x8b\x47\x9a\x38\x09\x44\x36\x3c\x06\xdc\x46\x84\x8d\x0f\x89\x93\x55\xe8\xf6\x2c\x95\x68\x61\x48\xb8\x1f\xbd\x43\x70\xc5\xbf\x17\xb3\xce\xc3\xea\x3c\x60\x0b\x49\xef\xe7\xda\x77\x78\xf6\x42\xf0\x81\x03\x07\xf6\xd1\x13\x98\x9e\x99\xc9\x04\xed\x4a\xa3\x67\x8c\x18\x00\x84\x78\xfe\xcb\x27\x76\xff\xb9\x6d\x78\xef\xc5\xdc\x7f\x61\xf7\x5f\x0a\x96\xc8\xdd\xd0\x6e\xc8\x6a\xfb\x00\xba\xc0\x05\xa8\x5d\x27\x37\x2c\xd5\x60\xe7\x9e\x19\x46\xc8\x8d\x17\x48\xa1\xda\xd2\xcf\x7f\x49\xe8\xe1\x58\xf0\xe1\xee\xf2\xcd\xbe\x27\x49\x6e\x3b\x8a\xe2\x83\x9f\x7c\xc7\x08\xdd\xf7\x9b\x2b\xa1\xfe\xed\x8a\x2f\x3d\x37\xc4\x10\xce\x28\xfb\x36\x8d\x29\x70\x65\x5d\xbe\x99\x6b\x7f\x5f\x02\xae\x2c\x36\x02\x12\x2e\x97\x08\x69\x07\x2e\xda\x4a\x39\xbd\x13\xe2\x4e\xba\x5d\xad\xb4\x05\x80\x0e\x5a\xdc\x3a\xb9\x5e\x96\xa8\x77\x55\x37\xcd\x17\x88\x6a\xb0\xdf\x3f\xc4\xfd\xc7\xb8\x93\xa0\xa8\x0c\xff\x34\x76\x68\x15\x8a\x7e\xb8\x3b\x1d\xfc\x6a\x93\x67\x1b\x96\xa3\x4b\x62\x78\x54\x0d\x2d\xb0\x64\xec\x37\x55\xd7\x8c\xc9\xaa\x60\xa3\xef\x4d\xc3\xf6\x57\xd6\xe8\x8c\x47\x5a\x30\x50\xc2\xf3\x5c\x73\x07\xcd\x72\x72\x3b\x40\x1a\xcd\x1f\x76\xc7\x85\xd6\x21\x68\x13\x80\xf0\x54\xbf\xb3\x29\xf3\x8c\xd8\xa4\x8e\xef\x13\xe2\x13\x83\x11\x5d\x8f\x99\x6f\x19\x26\x0d\xcc\xc0\x75\x29\xb1\x4d\x9b\x06\x81\x15\x10\xc7\x30\xe2\x48\x0f\x99\x6f\x30\xd7\x89\x09\x75\x4c\x12\xfb\x43\x40\x72\xf1\xf7\x03\xb9\xbe\x50\x94\x9d\xe6\x0f\x17\xfb\xde\xf1\xc5\xeb\x77\xba\xf8\x63\x54\x63\x0f\x0d\xc7\xee\x36\x49\x4e\xc4\x82\x2d\x7d\x68\x3e\x6e\xf4\x29\x2e\xb4\x9f\xfe\x31\xf0\xeb\x35\x29\xae\xf2\x04\x24\xdd\x0c\xe7\x34\x4c\x7f\xf8\x9d\x0b\xcd\x34\x00\x92\x81\x1f\xb3\x3c\xb9\x46\x6d\x0a\xc0\xf5\x1c\xd7\xa3\xbe\x15\x7a\xa1\x4f\x7d\x1d\xee\xf5\x28\x34\x7d\x83\x78\x06\x75\xec\x38\xf2\x42\xcb\x72\xed\x38\x66\x74\x68\x19\x94\xad\xd8\x35\x81\xcb\xe0\x82\xf3\x9c\x81\x37\xd2\x0c\x84\x63\x3e\x4f\x77\xef\x87\xc7\x43\x56\x56\xfc\x90\x8e\x8e\x57\x24\xff\x82\xe1\x0c\x7f\x68\x51\xe3\x48\xcc\xcf\xe7\xf2\x4d\xeb\x78\x22\xdb\xf1\x03\x3b\x08\x7c\x87\xb8\xd4\x77\x43\xcf\xb0\x02\x37\xd0\x43\xdf\x37\x0c\x4a\xad\xd0\x76\x6d\x2f\xd2\x4d\x6a\xc7\xb6\x11\x81\xb6\x1b\x7a\xd4\x32\x2d\xd3\x3b\x1d\x9f\xe1\x6f\x5c\x7f\x1f\x46\x11\xf9\xca\x07\x10\x04\x41\xab\x5e\x6f\xe0\x2d\xc7\xb4\x0c\xc7\x35\x3d\x63\xf8\x1a\x3d\xcf\x59\xc4\x80\x2a\x3e\xe7\x75\xda\xbb\x1b\x8f\x78\xc9\x69\x72\x3d\xbb\x5c\x76\x5f\xde\x1d\x35\xca\x97\x1f\xe0\xca\x62\xcd\x7d\xa4\x51\x78\xb2\xfa\x78\x2f\xb4\xde\x61\x62\xc1\x74\x87\xf1\x4b\x44\x15\x1c\x0d\xbd\x8e\x89\x2a\x02\xb4\x9d\xc4\xa2\x0f\xcb\x26\x3e\xa2\x40\x51\x02\x8d\x0f\x8b\x0d\x28\xe8\x8c\xa2\x29\x24\x47\xf3\x55\x29\xfe\x2e\x5c\x4e\xa8\xc7\xe3\xbf\x55\xa2\x14\xfc\x95\xc2\x69\x6c\xd0\x64\xc0\x0d\x26\xdb\xf4\x53\x9a\xdd\xa6\x8b\xc6\xe6\xfe\x46\xfc\x0e\x12\x56\x21\xad\x44\x6b\x86\xb4\x8e\xb6\x24\x90\xe3\x49\x6d\xe2\x40\x45\x6f\x86\xda\x1f\xa2\xfb\x26\xc3\x89\xb9\x11\xa3\x3f\xe4\x07\x18\x0a\xd4\x90\xa8\xac\xec\x51\xa8\x32\x62\x88\x46\x67\x82\x19\x9a\xd2\x57\xf8\x04\x23\x2f\x70\x07\xf9\x0b\xdc\xb8\xd0\x01\x03\xa1\x2b\xb6\x21\x40\x52\x0a\x63\x7c\xb9\x4c\x0a\x6e\x6e\x79\x66\xfa\xc5\x87\xbb\xf7\xfc\x44\xfb\x98\xdb\xf7\x3d\xed\x83\x6b\xdf\x66\x6b\xd8\x9c\xdd\x25\x6f\x74\x81\x90\xdb\x49\x77\xe4\x6f\xe7\x7b\x68\x09\x7c\xcf\xe5\x60\xff\xe7\xf2\x4d\xc3\x0b\x4f\x6d\xdd\x1a\x87\xf0\xd7\x93\xb6\x0c\x8a\xe8\xdf\x18\x11\x91\x52\xe6\xda\x65\xdc\xfb\x81\xd0\x75\x52\x14\x22\xde\x09\xe0\xbf\x9f\x09\xe3\x3b\x23\xb0\x84\xda\x24\x23\x14\x6f\x38\xde\xc5\xdd\x99\x18\xe0\x2c\xe2\xd1\x33\x4b\xb8\x00\x59\x3e\x6b\x4d\x2d\x5c\x59\x0a\x73\x01\xd1\xea\xe3\x06\xe5\xaf\x8f\x11\x08\x60\x1f\xcb\x2c\xfb\xb8\xca\x6e\x91\x8f\x08\xb9\xea\x63\x9a\x95\x1f\xe1\xc2\xc8\x6e\x05\xdb\xa9\xc5\xa4\xee\x0f\xf8\xe5\x9a\xa4\xf7\x1f\xa5\xb8\x87\xcf\x80\x90\xc3\x84\x52\x96\x7e\x84\xfb\x32\xd9\x24\xb0\x7f\x92\x2d\x81\xc0\xc8\x3e\x4a\x46\xa3\x30\x12\x4d\x02\xdd\x11\xee\x5b\x0b\xdb\xf5\xec\x84\x1d\x5b\x04\xf6\x9c\x4c\x09\xe9\xca\x76\xc2\x8e\x88\x9d\x6e\x58\x54\xff\xc2\xa9\x7c\x9f\x9f\x37\x78\x61\x8a\x17\xbc\x55\xbc\xb1\x0f\xfa\x88\xe3\x04\x0e\x00\xf1\x68\x9d\xa4\xf0\xd5\x8a\xbb\x70\x91\xe5\xca\x83\x93\xbe\x4d\x71\xbf\x00\x2e\x56\x6e\x63\xfe\xbf\x98\xbf\xcd\xf2\x3c\xcb\x79\x38\x57\x98\xa4\x04\xc3\xa7\x18\xc9\xa3\x25\x8f\xa0\x7a\xc8\xa5\x0b\xdf\x8f\x7a\x74\xb7\x70\x31\xe5\xf0\x64\x0b\x10\x4a\x83\xbd\x18\xb9\xef\x6a\xc2\xa0\x22\x0e\x0b\x47\xa2\xea\xed\xb4\xb1\x6c\x8a\xe9\x12\xf1\x5c\x8d\x0c\x12\x57\xa8\xb0\x2e\x74\x27\x85\x01\x2b\x1a\xe3\x4e\x6b\xb4\x78\x4a\x5b\x83\x8c\x4d\xab\xaf\x60\x80\xac\x9c\x49\x64\xe6\xcf\xde\x71\x3c\x5a\x00\xa4\x88\x4a\x14\xa7\xe6\x76\x78\x02\x94\xf9\x16\x37\xec\xa5\xc0\xc5\x57\x8b\x2f\x93\x07\x57\x48\xf4\x4e\x80\xf5\xcc\xb8\x71\x03\x7d\xe3\x0a\x16\x1e\x8c\xf3\x5f\xaa\x60\xbf\xc3\xad\x79\x0d\x8d\xee\xa5\x82\xbc\xbd\xdb\x00\x82\xb0\x9d\xd5\x10\x25\x66\x77\x48\xaa\xe4\xeb\xd9\x41\x90\x44\x0f\x8d\xf0\xbf\xce\xf0\xaf\xa7\xe8\xf4\x3a\xe5\x24\x8e\xb1\x21\x95\x8b\x56\xfc\x06\xdc\x80\xac\x40\x11\xa5\xe2\x05\xe1\xf5\xe5\x2f\xd5\xbf\x88\xd7\x1b\x26\x0d\x17\x15\x48\x9f\x62\x65\x55\xfc\x64\xc6\x21\x51\x0c\x79\x40\x9d\x2a\xd3\x84\x07\x59\x7a\xcd\x69\xa8\xe1\x45\x4b\x96\xe4\x95\x26\x85\xde\x4d\xf8\x06\x19\x0f\x00\x4e\x91\x80\x80\x20\x81\x30\x17\xea\x30\x0b\x80\x8a\xad\x80\xb6\xd2\xa2\x84\x8b\x02\xc9\x3e\xa1\xc5\x7f\x88\x19\x90\x63\xc7\xe9\x01\x1f\x5e\x16\x1f\x72\x10\xda\x0f\x35\xa8\xf5\x65\xd6\x07\x0d\x5f\xaa\xfe\x73\xf9\xa6\xd0\x46\xff\x8c\x0e\x27\x6e\x6f\x92\xe7\xe4\x7e\xf4\x1d\x10\x1e\xd6\x13\x10\x4d\x8a\x00\x43\x66\x38\x34\xa9\x98\xbe\x1d\x86\xc4\xd1\x59\xec\x79\x9e\xef\x07\x71\x6c\x10\xcb\xf5\x18\xd5\x43\xcb\xa7\x0e\x73\x5c\xd3\xf5\x0c\xdb\xf6\xbc\xc8\xd6\x29\x83\x67\x9e\x11\x01\xbe\xba\x71\x10\x13\x78\x7a\xfa\x1f\x7b\xe6\x35\xdd\x8e\xd0\x7d\x87\xde\x9f\xf6\xe4\x27\x36\xfc\x71\x36\xf7\x47\x9a\x4a\xfa\xbb\x26\x19\xa9\x64\xee\x27\x3b\x1a\x88\x53\x69\x9e\xb3\x4c\xc7\x32\xed\x93\x11\xeb\xb1\xae\xeb\x76\xec\x46\x91\xef\x87\xa1\x0d\x88\x4b\x02\x33\xd0\x3d\xcf\xf0\x99\x6f\xc6\xa6\xe3\x84\x7e\x8c\x66\x63\xdb\xb1\x88\x07\xcf\xbc\xc0\x63\xa1\x1f\x31\x62\x59\x81\x15\x9a\x86\xd3\x87\x5f\xd8\x2c\x2d\xcf\xea\x1b\x81\x40\x93\x4f\xcb\xc6\x30\x89\x13\x87\x9e\xa5\xd3\x90\x06\x7a\x0c\xf4\x13\x50\xc3\x75\xc2\x98\xc6\x96\x15\x45\x3a\x63\xd4\xf6\x58\xa4\xbb\x7e\x60\xf9\xb1\xcb\x98\x17\x7a\x91\x61\x12\x9b\x91\xc0\x1f\x30\xd0\x96\xaa\xb1\xd1\xb2\x80\x08\x83\x01\x6b\x30\xc8\x6f\xdf\xa3\x3c\x08\x2f\x19\xb0\x33\x8e\x17\xf4\x5e\x09\x59\xca\xe2\x24\x4a\xf8\xd5\x0a\xa0\x86\xb6\x1e\xd8\x91\xe9\xc4\xbe\x4b\x5d\xd3\x8f\x29\x75\x3c\x83\xc4\x40\xdd\x9e\x17\xeb\x54\x37\x02\x97\xc4\xa1\x3d\x60\x49\x87\xc9\xfe\x5f\x81\x92\xda\xb0\x65\xba\xcc\x4a\xb2\x7a\x1f\x65\x39\x1a\x79\x75\x33\x08\xfc\xbe\x69\xbb\xbc\x2b\xde\x65\x59\xc9\x01\xf1\x03\x1a\xd3\x20\x8e\xa8\xa1\x47\x01\x73\x2c\xea\xfa\x4e\x60\x46\xb1\x1f\x3a\xb6\x1e\x9a\xbe\x1e\x7a\x26\xb5\x7c\x23\xf4\xe1\x07\xd3\x32\x4d\x2b\x08\xcc\xd8\x62\x7a\x40\x7c\xdd\x0d\xc3\xd3\xa1\xd1\xff\xc4\x08\x88\xaf\x68\x98\xeb\x03\xc8\x23\xa2\x9a\xe9\xdd\x30\x8a\x5c\x6a\x1a\x76\x18\x05\xd4\xa7\xc0\xdc\x68\x48\x0c\x1d\xce\xc4\xb5\x22\xdf\x32\x3c\x6a\x04\x11\x0b\xbc\xd8\xd5\x23\x9f\x98\x2c\x76\x22\x27\x08\x43\x0a\x6c\xd0\x36\x5d\xa3\x3f\x7d\x45\xe9\xf5\x14\x86\xe3\xf9\x1e\x83\x73\xb1\x22\xdb\xd3\x99\x4f\x5c\xdf\x67\x2e\x2c\xd8\x23\x06\x63\x86\x49\x7d\xdb\x41\xae\x4b\xe1\x30\x4c\x6a\x46\x86\x1e\x30\x13\x0e\xc5\x74\xa9\xcf\x1c\x9b\x0d\xa1\xe3\x75\x8a\x64\x00\x83\x93\xd0\x0b\x4d\x2f\x86\xad\xf3\xa8\x19\x00\x37\x36\x99\x13\x52\xcb\x35\x3c\xdb\x23\x8e\x63\x38\x54\x8f\x22\x93\x0e\xc0\x99\x08\x56\xd9\x91\xb8\x15\xb1\x20\x4e\x30\xbe\x8b\xb3\x42\xcd\x30\xfb\x1b\x59\x4b\x4a\x23\x43\x3c\xc4\x4c\xcf\x8e\x73\xf1\xa0\xc8\x8b\x31\x3e\xe7\x3c\x4f\xeb\x61\xeb\x52\x9d\xee\xa5\xc8\x9a\x7f\x4a\x56\x20\xb9\xca\x4c\xaf\x55\xf3\xc2\x88\xb8\xf9\xb6\x7e\x8f\x1b\xf2\xe0\x5e\xa1\xdb\x48\xd8\x2f\x16\x3f\x5c\x7d\xfc\xfe\x87\x3f\x73\x5d\xef\xed\x8f\x7f\x55\x14\x43\x94\x1f\x49\x98\x2c\x5a\xc6\x0b\x11\x1a\x89\x93\xcf\xb4\x35\x46\x6c\xc3\x28\x1c\x0a\x19\xb2\x54\x29\x55\x29\xe8\x7f\x0b\xf9\x6f\xb5\xe1\xe0\x20\xbd\xfb\x5b\x8c\x72\xe8\x28\xdd\x5f\x9a\x4a\x86\x1b\x20\x8e\xe4\x0b\x54\xc7\xa6\x2e\xea\xd1\x0b\xfa\x60\x49\x88\xef\xc5\xd0\x85\xfa\x90\x30\x33\xe5\x0f\x9e\x9a\x10\xc8\x63\xca\xcd\xcb\x31\xf0\x90\x71\xdf\x88\x4f\xe5\x7a\x6a\xa2\xad\xa2\xf8\x1e\x45\xb7\xdd\xcc\xcc\x09\xd2\xfd\xa0\xbe\x2a\xed\xf4\x70\x59\x21\x91\x81\xd0\xde\x8a\x32\x55\x32\xe3\x7e\xaf\x94\x56\xed\xc6\x57\x62\x6b\x6d\xc7\x6f\x42\x6f\x48\x12\x68\xc8\x3b\x4f\x45\xb6\xf8\xf9\x86\xd5\xf8\x36\x61\x3c\xf9\x5b\x63\xfb\xeb\x9b\x4e\xe0\x2c\x52\x61\x5a\xe7\x83\x7d\x79\xe7\x3b\x7a\x86\x53\x5b\x76\x05\x6b\x41\xef\x52\xa1\x6c\x1a\x1e\x4f\x56\x3c\x7a\xc3\x56\x78\x31\x63\xde\x36\x4a\x89\x3c\x08\x5a\x44\x53\xd7\xc3\x4f\x73\x17\x11\x63\x58\x7f\x56\x34\xa2\x01\x5a\x4c\x8b\x55\x06\x3f\xa2\x6f\x43\xc8\x0a\x8c\x44\xcb\x7a\x64\x2d\xbb\x61\xb9\x34\xc2\x46\x28\x5e\x98\xb6\xb6\xcc\xb6\x39\x5a\x66\x73\xee\x0a\x15\xee\x91\x8e\x3b\x50\xa6\x28\xb1\xf5\xa6\xbc\x17\x66\x5f\xf9\x82\x46\x33\x56\xa4\xa7\x32\xd2\xb9\x59\xc0\x4c\x63\xf3\xeb\x39\xca\x1e\x45\xb6\xca\x78\x80\xf5\xfc\xf7\x82\x17\x72\x8d\x5d\xdc\xc8\x59\x96\x5f\x3f\x96\x92\x78\x35\x07\x3e\x12\x49\x93\x7f\x11\xd5\x21\x31\x82\x0c\x62\x5a\xad\x60\x20\xea\xa9\x47\x37\xc3\xcd\x27\x45\x24\x43\xfe\xf0\xf2\xe1\x19\x26\x05\xfb\x59\xf8\xc2\xa5\x25\x12\xd5\x2b\xbd\x1a\x05\x6f\xaa\x4f\x6c\x53\x4e\xdf\x48\x03\xb9\x39\x43\x39\x17\xdd\x94\x11\xf6\xb3\xf0\x5f\x0b\x37\x99\x32\xe3\x2a\xc1\x9a\x01\x7d\x37\x84\x84\x10\xf6\x5f\x35\x68\x0c\x1d\xe6\x58\xf2\x48\x3b\x7d\xc4\xb5\x7b\x8b\xe0\x0e\x8e\x7d\x56\xb1\x26\x77\x9a\x2c\x2a\x11\xcb\x35\x74\x72\x5c\x74\xe1\xb8\xa8\x1d\xfd\xf8\x48\x3f\x00\xfe\xe7\x4e\x27\xef\x70\x73\x5a\x3e\xdc\x09\xd8\xbf\x21\xb4\x92\x5a\x1a\x8a\xc2\x91\xe8\xb6\xb2\xd0\x1c\x4a\x53\x6d\xd6\xaa\x55\x83\x4e\x53\x55\xcd\xc8\xea\xf7\x45\xe4\x84\x60\xae\xdb\x4d\x96\x76\x72\xb2\x64\x7c\x89\x7c\x59\xa6\x6f\xa0\x27\x38\xc2\xd4\xe7\x94\xdd\x4a\x38\x40\xc8\x00\xe6\x5f\xcc\x55\x2d\x0f\x7d\x7c\x2b\x4c\x28\x89\x4b\xc9\x9c\x43\xc5\x91\x20\xf2\x76\x78\xd8\xee\x32\xc3\x14\x4b\x06\x80\x30\x99\xee\x32\xe3\xc5\x1b\x0a\x58\xb5\x40\x35\x79\x13\x60\xb6\xb5\xf8\xba\x10\xa4\x54\x03\x86\xaf\x47\xd1\x16\x5d\xc4\x22\x34\x04\xbd\x7c\x68\x45\x24\xc8\x36\x04\x1c\x49\x75\x7d\x3c\x05\x1b\xd8\xa6\xc9\x5d\x63\x70\x52\x39\x82\xd8\xdb\x31\x86\x90\x66\xb7\x4f\xc5\x04\x38\x3d\xef\xb3\x86\x9a\x01\x70\x90\x77\xa1\x7f\xfd\x20\xd8\x2b\x00\x2b\x6c\xdc\x07\x46\xb8\xa5\xb9\xfe\x2f\x5c\xae\x35\xfa\x27\x2a\x46\x33\x6e\x1f\x88\x61\x30\x34\xa0\xdc\xa0\x6b\xa9\x00\x62\xd7\x6e\xb3\xed\x8a\xa2\xbb\xe8\x16\xe3\x93\xe0\x81\x44\xab\xa2\x29\x6b\xf3\xf0\x62\x5a\x9e\x81\xe7\xce\xcc\xde\xc3\x41\x1f\xc0\xcb\xa4\x18\xd4\x3d\x01\x9e\x3d\x06\xb7\xf3\x16\xeb\x1b\x25\x20\x52\x89\x12\x47\x18\x0b\x71\x87\xf1\x2a\xad\x88\xbb\xa9\x70\xb9\xa6\xb0\xcf\x10\xe7\x93\xd1\x6e\xd2\x7d\x5f\xde\x55\x85\x7d\x76\x12\x2d\x31\x46\x4c\x9a\x8e\x44\x08\xcd\x5b\x19\x13\x87\x85\x7a\x78\x50\x01\xc3\xe4\x15\x22\xc4\xfe\x3c\xc9\x28\x16\x8a\xc1\x7c\xca\x26\xee\x86\xb3\x9f\x35\xb9\x47\x54\x2a\x56\x98\x60\x00\xbf\x67\xdb\xf2\x2c\x8b\xcf\x28\x7c\xf9\xec\xa2\xcd\x70\xbb\x5b\x11\x67\xe2\xb8\x60\xaf\x0e\x3c\xab\xef\x81\xd3\x75\xb7\xfa\x81\x20\x13\x19\x59\xd8\xec\x3e\xb9\x26\xe8\xb2\xed\x5d\x1c\x75\x10\x86\x0c\xc2\xb9\x5d\xde\x73\xcc\x6b\x82\x1b\xe7\xda\x0f\x28\x16\x36\x31\x51\x3c\xfd\x8c\xa0\xa3\x49\x31\x29\x8a\x6b\xa2\x4e\x76\x94\x57\x1f\x86\x4f\xa5\x82\x53\xa7\x68\x57\xac\x83\x9f\x58\xca\xf3\x1f\xb9\x99\x52\x64\x6e\xf2\x57\xcf\x30\x13\x0c\xee\xab\x22\xc1\x2b\x51\xa4\x0a\x03\x4a\x2a\xeb\xee\xe1\x54\x45\x45\xbb\x5c\x43\x22\xa0\x6a\x1f\x06\xc9\x97\xb5\xaa\xf6\xbf\x40\x65\xa8\x8e\x0c\xe3\x49\x66\xcf\x9a\xcb\x1d\x64\xd2\x98\x54\x7b\xe0\x84\xd0\x6f\xd7\x46\x7b\x25\x8f\x0b\x83\xd7\x4a\xb6\x1f\xfa\xbf\xc5\xb8\x56\xac\xe9\x72\xd7\x0e\x6d\x9d\x26\x81\x63\x22\xe4\xa3\x6c\x6f\xfd\xc0\xf9\x2f\x08\x03\xa6\x4d\x49\xc9\x88\x79\xf5\x41\xaf\xbe\xea\xcf\x3f\x5e\x02\x0d\xdc\xa8\xf6\xf8\x26\x01\x7a\x20\xe3\x52\x79\x24\xa2\x61\xa1\x16\xa6\x13\x01\x49\x0f\xb2\xe1\x7e\x31\x3b\x05\x1d\x5f\xfe\x9d\x85\x05\x8c\xc2\xca\x57\x4a\x59\xbb\x5a\x78\x2f\x1e\x83\x2b\x57\x59\x91\x94\xfd\x48\xc4\xdf\x4d\x02\xc3\x68\x30\xc5\xf4\x67\x3f\xc0\x86\x23\xdf\x38\xdd\x13\x7f\x1f\x8e\xa1\x98\x8a\x99\x39\x39\x24\x36\x62\x32\x2e\x62\x87\x68\x98\xe3\x46\xc2\xf4\x09\x40\xf1\x4c\x1e\x9f\x00\x84\xbb\x70\x9a\x2f\x4b\x35\x0e\x50\xae\x88\xef\x35\x78\x03\x10\x3f\x21\x48\xb6\xfc\x22\x56\x44\x8a\xb7\x44\x54\x02\xe3\x85\x0a\x65\xd4\x72\xa9\x2f\xce\x16\xa5\xbd\xa8\x8a\x0b\x12\x0c\x02\xc5\x97\x78\x8e\x74\x51\x31\x74\xe1\xbf\xc4\xc8\xcf\x7b\x29\xd9\xae\xe7\xda\x8f\xfc\x15\x51\x4d\x02\xbf\x42\x01\x49\x78\x3f\x43\x8c\x36\xde\x30\x80\x89\x2b\xd0\x4c\x10\x2f\x0f\xcf\x2b\x18\xfe\x5d\x46\x84\x03\x6a\xae\x89\x14\xd7\x39\x54\xff\xa5\xdf\xcd\xe7\xba\x31\xe3\xff\x30\x17\xbd\xb2\x4a\xc7\x64\x02\x8d\x18\x83\x33\x3f\x20\xc4\xec\x28\x8b\xf4\xce\x49\x4a\x35\xb8\x49\xc2\x03\xcd\x78\xfe\x47\x5f\xdb\x2c\xf5\x27\x82\xa0\xcc\x36\x49\xa4\xd7\x00\xf4\x27\x36\x9e\x72\x62\x63\x62\x62\xf3\x29\x27\x36\x27\x26\xb6\x9e\x72\x62\x6b\x62\x62\xfb\x29\x27\xb6\xbb\x13\x3f\xff\xeb\x6d\xd4\x43\xfe\x34\xd7\xdb\x61\xb9\x7c\xa3\x5e\xf5\x93\xd6\x5f\x3b\xf7\x46\xdb\x39\x7e\xfc\xab\xa3\x1a\xff\xb1\xb7\xc7\x53\xf2\xdd\xf2\xee\x87\x5d\x14\xc8\x43\xa9\x42\x84\x52\xa9\x2c\x18\x4b\x30\xf0\x05\x23\x72\xa3\xfe\xde\x54\x61\x8e\x07\x78\x32\x96\x15\x64\x9f\xe1\x66\x28\xb3\x4f\x70\x69\x76\x66\xab\x80\xa8\xf3\x8c\x3e\x17\x1c\xdd\x09\x9f\x03\x1b\x79\x8c\xf7\xff\x0b\xe5\x26\x03\xba\x16\x08\x54\x4f\xc1\x2e\x94\x32\x99\xa7\x85\x86\xb3\xec\xc4\x34\x24\x0d\x55\xa3\x23\x02\x35\x4a\x9b\x30\xc7\xc3\xdf\xb3\xb5\x0c\x82\x43\x5a\x23\x58\xa9\x09\x96\x5c\x24\x75\xbe\x12\x89\x63\x11\xc5\x20\xf1\xb0\xf1\xd2\x1c\x93\xe7\xfc\x1e\x70\xf8\x1b\x38\x98\xc7\xe1\xef\x30\x4a\x99\x9f\x09\xa7\xb4\x1b\xf3\x48\x68\x55\xe9\x10\x1d\xfc\xea\xca\xd8\xa2\x24\x29\x5a\xe0\x09\x10\x29\x30\x35\x92\xa2\xdf\x48\xbc\xc3\x45\x26\x39\x5e\x1d\x63\xc6\x5f\x6c\xf9\x09\x51\x65\x82\x11\xaa\x5a\x7b\x7c\x55\xe8\xcd\x5b\x15\x99\xd4\x75\x88\x84\x02\xd5\xa0\xd5\x0a\xf4\xd5\x42\xe8\xac\x33\x74\x42\x89\xf2\x76\xd2\x13\xc0\x21\xac\xca\xf5\x2b\x7a\xd9\x37\xf8\xbd\xcc\x1b\x8f\x61\x35\x77\x58\xca\x3f\xf9\x17\xaf\x46\x89\x7e\x4b\x25\x03\x9d\x57\x78\xd1\x38\x01\xc1\x0f\x1a\xdf\x84\x45\xbc\x79\x07\xff\x14\x0a\x1d\xbe\x84\xd3\x83\x3e\xb7\x21\x51\x52\xde\x2b\x26\x39\x43\x37\x6d\xee\x4f\x15\xcb\x08\xe5\xb4\xf0\x95\x65\xca\x0c\x75\xf9\x50\xc9\x83\x1f\x99\x34\x4f\x30\xaf\x12\x8b\xaa\xc9\xd1\xc4\xe7\x4b\x52\x68\x6b\x74\x94\xf2\x2d\x40\x8a\x4f\x65\x6e\xa3\x80\x46\x0d\x6d\x61\x72\xcd\x31\xd6\x43\xc2\xea\x6b\x42\x95\xac\xd8\x80\x58\x0e\x3f\xa5\xb9\xf6\x21\xd3\x78\x54\x02\x49\xf9\xc8\xb0\xb7\xe4\x13\xac\x7c\x69\x54\x05\xf9\x4d\x5e\x8d\x90\x9f\x51\x72\x7d\x86\xe1\x17\xf0\xaa\xa8\x5e\x59\x1d\x9e\x28\x53\x6a\xd7\xdb\xc4\xcb\x14\xda\xcd\xcc\x78\x72\xe1\x0a\xc6\x35\xc3\x33\xd3\x71\x71\x2d\xcb\x26\xd5\x18\xbf\xc2\x3d\x5a\x24\x3c\xfe\x77\xf1\x93\x3e\xd3\x3e\xbd\x5a\xcc\x00\xc7\x19\x6e\x66\x52\x6a\x0b\xaa\xfd\x6f\xcd\xe7\x75\x1c\x71\x50\xfc\xf7\x73\xf9\xef\x0b\xf8\x1d\xf3\x87\x45\xe9\x41\xf8\xe1\xbf\xb4\x97\x4b\x43\xfb\xbf\x5a\xa2\xfd\x51\x5b\x9a\xaf\xe0\xc3\x97\x2b\x96\xbe\xc4\xd7\x5e\xc1\x23\xff\xd5\xe2\x69\x45\x2f\x81\x33\x07\x8b\x14\x9d\x5a\xa8\x82\xa8\xb9\x3b\xf6\x42\xd3\xe7\x58\xc0\x65\x54\x55\x21\x39\x70\x9a\x41\x9c\x12\xa1\x55\x19\x92\x0e\x4f\xed\xc7\x22\x97\x3f\x19\xec\x2c\x98\xc1\x98\xce\x3f\x7e\x67\xac\xdc\x3c\x36\x2f\x17\xfe\x81\xa7\x60\xe6\x4d\x05\xb3\x9d\x04\x03\x24\x6a\xee\xce\x12\xb1\xed\x02\x2e\x6e\xfc\x09\x19\xac\x89\x29\xbe\x30\xc9\xcf\x1f\x52\x3f\x34\xed\x35\x77\x5b\x49\x8f\x11\xf7\xbd\x09\x87\x44\x22\xe8\xb6\x19\x51\x56\xc5\x95\xa1\x20\x43\xd6\x2f\xe1\xb4\x12\x09\xfe\x92\x7d\xd4\x25\x00\x7e\x4b\x3b\xd8\xd1\x1c\x6e\xc7\xd4\x97\xfa\x3a\x5b\xf6\x44\xb3\xd7\x6a\x87\x0a\x00\xee\xb3\x48\xe2\x1f\x01\xa7\x3e\xb9\x27\x82\xaa\x1e\xff\x81\x6d\x89\xdb\x05\xb1\xf6\x03\x41\xad\x4c\x3c\x00\x03\x5c\x1a\x80\x3b\xbc\x1e\x32\x29\x4b\x22\x0b\xb7\xf3\x6b\xb3\x86\xe4\x99\x38\xf3\x65\xa5\xc6\xca\xa5\xd9\x66\x60\x3c\xb0\xef\x29\xf8\xd7\x01\x01\x9e\x0d\x27\xeb\xc5\x78\xce\xb5\xbf\x8a\x30\x5f\x19\x4c\x29\x39\x06\x10\xfa\x8a\xdc\x4b\x8f\x67\xc1\x7e\x5e\xcc\xd4\x28\x2f\x38\xb1\x7b\x31\x5c\x89\x49\xe1\x18\xee\xa9\x16\x5c\xde\x85\xf6\x61\xcc\x83\x31\x6c\x8f\xf8\xac\x9d\x03\x48\x91\x15\x77\xa2\xc5\x40\x4e\xc7\xb8\x51\xce\x9a\x9f\x17\x62\xca\xb8\x49\x44\x4a\x8a\xbd\xb1\xce\x79\xd1\xeb\x7c\x87\x8a\x46\x4d\x87\x2d\xb5\x94\x51\xce\x08\x6f\xbf\x22\x86\x19\xc0\xb5\x56\xa5\xd9\xaa\x8d\xc4\x17\x9b\x49\x02\x6b\xf8\x81\xc3\x7d\x2a\xed\x99\x5f\x6a\xec\x85\x68\x3a\xa7\x9c\xa3\xac\xf9\x7b\xc6\xc5\xc9\x03\x4f\x53\x89\xed\x12\x05\x84\xf9\x60\x0f\x84\x4b\xb4\x8a\xb9\x0b\x5b\x87\x54\x18\x5b\x81\x2e\x5f\xd8\x59\xcb\xda\xc1\xef\x70\x81\xf2\xc4\x9f\x65\xf1\x63\xbe\x00\x95\x9e\xb1\xe4\xdc\xd9\x06\xeb\xa6\xb0\xdb\x03\xd1\xe0\x4a\x7c\xdd\x28\x8f\x3b\x54\x2f\x79\x5f\xb5\xaf\xc1\xf9\xa5\x0c\x3d\x14\xc9\xdc\xc4\x8d\xc9\xfa\xfb\xd5\xfb\x20\x9e\xc0\xe7\xd7\xf7\x4a\xbb\x31\x10\xd3\x44\x72\x01\x97\x1b\x93\x92\x4b\x97\x20\x29\x95\x4b\x25\xad\x4d\x94\xb0\x13\x01\x1c\xbc\x05\x01\x95\x19\x71\xb2\xec\x5d\x3d\x1f\xaf\x7c\x24\x34\x6f\x2c\x79\x22\x04\xd5\x75\xe5\x94\x95\x41\x20\xfc\x37\x2e\x5e\xa7\xa2\x94\x56\x53\xa7\x28\xc7\x54\x17\x2c\x9b\x82\xca\xb1\xb8\x69\x61\x37\x31\x96\x99\x0b\xcb\x8b\x46\x85\xc6\x17\x70\x30\x24\x26\x78\x1f\x44\xf7\xe2\x53\xc2\x0b\xf7\xc9\x9b\xb0\x59\x2b\x9f\x5c\x89\xcc\xae\xec\x2e\x28\xf4\x7c\xa1\x05\xdf\xae\xe0\xc0\x24\x86\x3c\x4b\xc2\x51\xe0\x07\xba\x69\x5e\xc0\x51\xe4\x3b\x62\x40\x59\x6e\xbd\xae\x10\x36\x60\x04\x97\x6d\x17\xfa\x3d\x38\xa6\x85\xed\xaa\x5b\x03\xa0\xd0\x36\x05\xd4\xfa\xfb\xdb\xcb\x19\x8c\xcf\x50\xd2\xa8\x50\x76\xc9\xee\xfa\xa3\xb4\xc2\xa3\xbc\x38\x36\xe2\x40\xb7\x4c\x8f\x10\x3d\xf6\x15\xbb\xbd\xe8\xf6\xb0\x2f\x54\xb2\x47\x44\x22\xec\x49\x87\x01\x15\xc5\xae\x69\x1b\x8e\x4f\x9d\xc0\xb0\x02\x25\x04\x4b\x36\x86\x9c\xee\x56\x32\xa5\x13\x28\x77\x0c\x1a\xdf\xa2\x76\xb9\xb0\x1a\x06\x51\x1a\x5a\x3d\xbf\xef\xd4\x16\x42\xc3\xc7\x28\xfb\x85\xf4\x81\xeb\x86\xeb\x0c\x04\xe9\x8c\xfb\x46\xaa\x72\xbf\xfb\x94\xb7\x6b\xef\xe6\xb1\x8b\x80\xa8\xe5\x81\x47\xa0\x1a\x12\xa0\xbb\x42\xb4\x65\x8e\x43\x3d\x58\xd5\xa4\x53\x73\xf8\x11\x53\xbb\xf6\xf8\xd4\xa3\xc5\x45\x06\xa8\x74\xcf\x83\x98\xa0\xb5\x31\x8a\xdb\x73\x86\x51\xc2\xa9\xb8\xa3\x68\x9e\xf3\x38\x64\x7a\xd4\x9f\x06\x24\x94\x09\x0e\x6e\xae\xc4\x07\x80\xdb\x0d\x2f\xd9\x5e\xd1\x8d\x5e\x1b\xab\xc9\xb6\x3b\x33\x51\x2d\x0d\x9d\x11\x31\xa6\x17\xa1\x41\x7e\x88\x27\xf0\x22\xde\xfc\x07\x95\x05\x0d\x11\x6e\x34\xc8\xa2\x26\x39\x9e\xab\xe3\x7f\x6c\xdd\x31\x5d\xd8\x24\x5f\x8f\xa9\xae\x13\xc3\xc5\x8a\xda\x04\xfe\x63\x5a\xba\xe3\x9b\x7a\x64\x5a\xd4\x22\xcc\xa4\x91\xef\x12\x6a\xc0\x43\xd7\x20\xa6\x6f\x06\xd4\xf7\x22\x2f\x0a\x7d\xdb\x72\x2c\xd7\xb1\x03\x33\xa4\x86\x63\xfb\x2c\xf4\x98\x17\x47\x7a\x6c\xb9\x96\x19\x32\xc0\x69\x33\x90\xcd\x62\xa5\xe0\x37\xb5\x0c\x6e\xcb\xdb\x73\x1d\x8f\xc4\x0e\x43\x42\x27\x0a\xec\x5f\x9c\x3c\x10\x25\x89\x3e\xe3\xaa\x91\xf4\xe8\xe5\xda\xe7\x9f\x3b\x5d\xae\x42\x86\xa2\xc0\xd4\x93\x38\x81\xab\xe3\x25\xf7\x7c\x58\xe6\xab\x93\xa7\xe6\xb2\x23\xfc\x75\x6f\xfa\x18\x58\x8f\x6c\xe2\xf6\x72\xc9\x30\xd1\x65\x70\x29\x1d\xd6\x3b\xce\x74\x77\x81\x67\xdc\x50\x22\xe0\x69\x27\xda\x9d\x4c\xb3\x63\x89\x19\xef\x95\xbc\xa3\x09\xdc\x58\x25\x31\x8b\xee\xa3\x15\x6b\xd7\xdf\x1e\x42\x91\xa2\x35\xe2\x14\xa6\xc3\xfe\xb5\x79\xe7\x99\x26\x8b\x61\x77\x9e\x4a\x1f\x40\xe7\x69\x63\x77\xef\xbe\xce\xd3\xda\x3b\x0f\xab\x4a\xa3\x9d\xc7\xb2\xbc\xf7\xd0\x66\x75\x7f\xea\xc6\x54\xec\xd7\xd3\xa2\xb5\xb5\x52\x84\x43\x1e\x29\x80\x45\xae\xd9\x83\x50\x64\xf1\xec\x4b\x6b\x4a\xee\x8f\x4c\x02\xbb\x05\xd9\x4c\x2e\x66\x30\xe9\xa2\xb5\x6a\x59\x97\x16\x0f\x1c\x51\xa9\xe9\xa7\x27\x06\x78\x5d\x1e\x0f\x6b\x3b\x89\xa1\x3c\xf5\xb0\x0f\xf2\x2e\x78\xac\x94\xb4\x1f\x46\xe5\xbb\xaa\x7c\xd6\x57\x2e\xf7\x1f\xc5\xe5\x9a\x1c\xba\xfd\x8f\x53\xe5\x7f\xcd\xa1\x9e\x3c\x55\x78\x7f\x03\xaa\x08\x4c\x7c\x0c\xb8\xc2\x55\xa7\xbd\x14\x5e\xb5\x31\xf4\xa3\xa1\xad\x9b\x1e\x4c\x1e\x9a\xc4\x8f\x99\x1d\xf9\x56\xe4\x52\x12\x83\x94\xe3\xbb\xae\x07\x48\x69\x84\x3e\x51\xcb\x07\xb6\xab\xb7\x1d\x0d\xd1\xea\xec\xea\x12\x2b\xc7\x55\x35\x4e\x78\x1f\xe5\x4d\x2b\xfc\x65\xa6\xe9\x42\xae\x2c\xab\x1c\x30\xfe\xc5\x20\x12\x28\x95\xe5\x9a\x8a\x72\x8f\x51\x75\x1b\x43\x50\x35\xdc\xd0\xbc\x5c\x7a\xe6\x3f\xc8\x68\xba\x41\x86\x24\xe2\x80\xb2\x76\x89\xa9\xaf\xbc\xe9\x2b\x6f\xfa\xca\x9b\x0e\xe6\x4d\xdc\x8b\x75\x99\x52\x76\x77\x3c\x34\x4b\x70\x38\x64\x41\x32\x10\x40\x38\x22\xaf\xd1\xb4\xc0\x5b\xc7\x72\xc7\x2c\x90\xee\xd0\x2a\x9a\x13\x8e\xb6\x79\x91\xe5\xfb\x6e\x5a\xb6\x21\xa0\x60\xcb\x38\x25\xdc\xb8\xb8\x9e\x6e\x26\xbb\x0a\x6c\x08\x77\x06\x63\x4c\x98\x28\x19\x5e\xcc\xc5\x5c\x8a\x7a\x2e\x0a\xd2\x71\x73\x76\xab\xf0\xcd\x10\xb1\x37\x76\x85\x13\x19\x1c\x58\x25\x58\x0e\x33\xa3\xf4\x0b\xa1\xea\x84\xee\xb0\xb9\x15\x08\x92\xf1\xed\xca\x29\x9f\x9c\x3f\xf2\x22\xc0\x47\xdb\xc2\x77\xdf\x5f\x81\x66\x25\xca\x7b\x8a\xa5\xe0\xf8\x88\x22\x7c\xdd\x83\x9b\xa9\xd4\x1f\xae\xeb\x0e\x1f\x6d\x3f\xc5\x88\x12\x96\xcb\x37\xd3\xdb\x79\x84\x12\xc7\xe5\x17\xc5\xdc\xeb\x12\xca\x47\x06\xa6\xf1\x81\xbd\xc4\x02\x55\xb2\x73\x0c\xaf\xf4\xc3\x9d\x7e\x18\xd8\x88\xef\x6c\x0b\x22\xdb\x75\x2b\xe9\xb3\x83\x24\xd5\x2b\xf1\xac\x96\x76\x3e\x1a\x36\xa8\xf1\x55\xd2\x75\x00\x9c\x8a\x2b\x67\x75\xe5\xa6\x9c\xdd\x92\x9c\x8e\x20\xca\xfe\x05\xa6\xab\xc2\xd2\x47\x3b\x81\xdd\x36\x79\x08\xfe\x76\x69\x6b\xa5\xa4\xf5\xd1\x60\x2b\xb6\x75\x34\x38\xda\xd7\xd1\xab\xb9\x92\x02\xf5\xa9\x56\x44\x23\xd6\xd8\x6e\x41\xed\xaa\x90\xf6\xd1\x8e\x3d\x87\xd1\x78\x50\x73\x77\x97\xaa\x14\x7f\x71\xf2\x23\x67\x7e\xbc\x5a\xde\x6a\x0d\xef\xa3\xb1\xdc\x62\xbb\x91\x8e\x6d\x74\xb0\xc7\x72\x7c\x0c\xcb\x2e\x58\x39\x2d\x19\x34\x45\xc3\x9f\x66\xab\xab\xb6\xdc\x62\xa2\xb1\xed\x3d\x5a\xad\xf2\x56\x8d\xf2\x27\x47\x9e\xa1\xe6\x07\xea\xba\x8e\x57\x20\x5d\x16\x46\xdf\x73\x45\xad\xda\xe6\x3d\x37\x0a\xb6\x0c\xbb\x5d\x66\x62\x6c\x2a\x44\x3b\x35\xa8\xa3\xbb\x9a\xdd\x2b\xb2\x0b\xb7\x0a\x17\x58\xa7\x84\xb7\x32\xdb\x57\x22\x3d\x6d\xe2\x64\x6b\x91\x78\x26\xbc\x3d\xbc\xe3\x55\x96\x62\xc8\x59\x89\xcd\x5a\x57\xd9\xfd\x1a\xdf\xab\xd5\xcc\xd3\x91\x65\x39\xba\x65\x13\xe2\x04\x80\x6d\x4e\xe8\x82\xd0\x6f\x11\xdd\x74\x4d\xb8\x8d\x42\xb8\xd6\x3d\x93\x01\x06\x32\x5b\x57\x0e\x63\x57\x4f\x4a\x0b\x74\xf4\x92\xe3\xe1\x34\xa9\x86\x42\x82\xae\xab\x32\x33\x3a\xee\xd3\xa7\xa1\x15\x59\xb1\xed\xb8\x51\xdb\xe9\x46\x49\xdb\x22\xbc\x0b\x20\x49\xba\xd9\x96\xfc\x4b\xb9\x37\x63\x1a\x50\xed\xbc\x99\x3a\xc3\x9d\x04\xdf\xf6\xfc\x8d\x09\xa0\x2a\x13\x36\xd8\x52\xf9\x69\x14\xc8\xec\x30\xf5\x71\x88\x5c\x76\x01\x7c\x7f\x2d\xb2\xe9\x5b\x7c\x00\x8c\x4d\xc0\x36\x42\xba\x21\x89\x80\x93\x77\x84\x63\xe3\x6e\xd0\xa7\x51\x04\x78\x1a\x10\x97\xfd\xfb\xe7\x2c\xd2\x21\x81\xe1\x28\xda\xc2\xa0\x5c\xa0\x74\xa0\xae\xfb\x5c\xef\x09\xa1\x3f\x06\xe0\x8a\x60\x00\xda\xbd\x48\xbe\x41\x95\xba\xa8\x38\xe0\x88\x9a\x60\x05\x6d\x33\x0e\xb6\xd5\xde\xf3\x94\x7c\x99\x72\xb5\xc9\x41\xb4\xe5\x8a\x7d\x81\x49\x21\x7b\x2a\x27\x4a\x54\x4f\xd3\xad\xfb\x68\x07\x77\xda\x0c\x0a\x37\x9c\x14\x33\x91\x5b\xc9\x35\xcf\xea\x18\xa5\xb0\x5b\x81\xa7\x06\xda\x53\xee\x9e\xaa\x61\xf8\x21\x91\x34\x53\x3e\x2e\x71\xc3\xb4\xe4\xec\xa6\xf5\xf8\xb1\x90\x04\xdb\x68\xa2\x12\x82\x77\xc9\xb6\x10\xd5\x35\x23\xb2\x8a\x44\x60\xa3\x48\xad\x4b\x65\xd7\x45\xde\x78\x73\x5a\xde\xba\x26\xc5\xf1\x64\x6d\xae\x78\xad\x2b\xdb\x35\x42\x20\xc3\xf1\xe1\x22\xac\xeb\xb3\xca\xc6\x8f\xe2\x7e\x7f\x80\x63\xb5\xd5\x83\xa6\xe5\xf9\xd1\x24\x29\x6c\x66\xd7\x67\x06\xdc\x5b\x98\xc8\xd4\x8e\x68\x9b\x73\x7d\x5d\x7d\x41\x42\x02\x2f\xce\xab\x25\xa6\x4a\x30\xe4\x38\x47\x13\x3d\xde\xf7\x0b\x7a\x30\x03\xd0\xee\x3c\x66\xb9\x8c\xb8\xcc\x33\x31\x1b\x5d\xf8\xf8\xb0\xa9\xed\xd4\x5d\x98\x93\xdb\xc7\x48\x05\x95\xd1\xe4\xe1\x5b\x05\xee\x8e\x00\x94\x0d\xd0\x2d\x74\x42\x09\x0d\x02\x7b\x97\x68\x0c\xcf\x76\x41\xcc\x34\x3d\x43\x87\xef\x0c\xdf\x74\x4c\xdd\xc7\xbf\x45\x7a\xe8\xdb\x86\xed\x81\x42\x13\xd8\x56\xe0\xc0\x68\x81\x6f\x81\x0a\xa3\xeb\xcc\x05\xb9\xd5\xb3\xcd\x88\xfa\x9e\xc7\x22\x10\xfa\x02\x50\x67\x22\xa2\x83\xb8\xa7\x33\xdb\x34\x62\x2b\xd4\x0d\x8b\x51\xd3\x34\x2c\xd3\x66\x70\xff\x82\xd8\x4e\x2d\xdb\x75\x43\xcb\x0c\x0d\x18\x3e\x02\x09\xca\x80\x49\x83\x10\x5e\x89\x0d\x6a\x47\x96\xa7\x5b\xba\x03\x1a\x12\xa5\xa6\x47\xe2\x00\xee\x6e\xd3\xb5\x6b\x9b\xdf\xdb\x1b\x36\x1d\x5f\x29\x35\xf8\x43\xee\x47\x45\xf9\xaf\x65\x45\x81\x79\x75\xb9\x59\x1e\x15\x7c\xd3\x48\x8e\xa6\x3e\x26\x1f\x79\x8e\xeb\x51\xdf\x02\xa9\xd8\xa7\x3e\x1c\x04\x8d\x40\x13\x34\x88\x67\x50\xc7\x8e\x23\x2f\xb4\x2c\xd7\x8e\x63\xa6\x5a\x86\x78\x42\xef\x41\x7c\x70\x34\xa2\xeb\x69\x8a\xe3\xed\x28\x58\x1e\x77\x72\x21\x6e\xb6\x4a\xc3\x8c\x44\x78\xf0\x62\x21\xfb\x22\x40\x75\xf8\x5c\xf4\x28\x38\x3f\xe1\x82\x78\x71\x34\xd9\xad\xd6\x4e\x1e\x05\x9a\xb4\x45\x3d\x00\xdd\xfe\x6a\x8b\xb8\x29\xf6\x06\xad\xbe\x5f\x26\xc1\x19\x50\x52\xd4\xc0\x88\xa9\xd3\x3c\x86\x79\x6c\xe4\x06\x43\x89\x80\xdc\x1f\x8e\x2a\x8a\x91\xb0\x16\xa8\xb9\x10\xd0\x34\x66\x7e\x3c\xd6\xe0\xa8\x8f\xb9\x37\x9a\x13\xe2\xf0\x89\xa8\xd3\x31\x8b\x84\x09\xd7\x5a\x1c\x85\x51\x18\x5a\x76\x5b\x97\x14\x46\xcf\xe3\x00\x32\x69\x40\x75\x3c\x97\x19\xa0\xc3\xa1\x48\xdb\x05\x41\x04\x20\xed\xed\x25\x47\x9f\xb7\xb6\x86\x17\x8a\x9e\x6c\x81\x81\x3c\x03\xa1\x57\x9d\xd8\xf0\x36\x04\xef\x0e\x8a\x7b\xaa\x7a\x9a\xb5\x9a\x43\xa3\x8b\xfd\x8a\xa4\x49\xf4\x12\x71\xd6\x74\xdc\x57\x4d\x04\x94\x98\x8c\xf3\xda\x19\x46\x15\x4c\x81\x79\xaa\xa8\xb0\xdb\x12\x34\xf8\x63\x07\xa6\x57\xf7\xe1\xeb\xfe\xed\xba\x43\x4c\xf1\x48\x02\x90\xfa\x02\x5a\x64\x60\x77\xea\x7b\x57\xd2\xd8\xac\xea\xe9\x1d\x65\xb9\x48\x16\xe1\xd9\xf6\xd2\xdd\x89\xd5\x46\x06\x46\x1b\x32\xf4\xb4\x92\x08\x1f\x92\x0b\xe5\x6f\x37\x55\x8e\xc7\xd0\x52\x8f\x58\xc9\x78\xb0\x94\x5b\x5d\xa4\xec\x33\x00\xd0\x94\x80\x12\xb6\x39\xb2\x5a\xbd\x51\xae\xf8\xc7\xc4\x0b\x4f\xdd\x16\x13\x36\xae\x47\x9a\xae\x5a\xe6\x3e\xac\x02\xff\x84\x1a\x96\x74\x6d\x71\x2b\x0a\x4c\x5b\x37\x9f\xef\x29\x9e\x7b\xef\x16\x16\xb4\x40\xdd\xac\xaf\x3c\xe2\x92\xf6\xbf\xb7\xc4\x57\xf5\xf5\xf5\x72\x5d\x5c\xcf\x85\xb0\x54\x09\xb1\xbd\x0e\xb4\xe2\x98\xf9\xcd\xc5\xf4\x10\xc4\x76\xe2\xb9\xf6\x80\x95\x91\x73\x6e\xd7\x75\x6c\xcb\xf5\x5d\xc3\x0d\x5c\x66\xea\x8e\x0d\x7f\x8f\x3d\x53\xc1\x2a\xd1\xaf\x7d\x0a\xaf\x0e\x39\x78\x6e\x7f\xe3\x6c\x8f\x7f\x3e\x76\xb9\xe9\x96\xe3\xb8\xc4\xb3\x22\x50\x4e\x2c\x1f\x64\x6f\x33\x8e\x50\x48\xd2\xe3\x28\xa0\xb6\x4b\xa8\x6e\xd8\x7e\xac\x7b\x0c\xf4\x0d\xc3\x63\x86\xe1\x85\xd4\x00\x01\x25\xa0\x81\xed\x87\x8a\x47\xbc\xcf\x18\x8e\x62\xb0\xe8\xb0\x81\x41\x06\x70\x94\x89\xfa\x15\xdf\x8e\xee\x83\x14\x6e\x47\x20\x0b\xba\xc5\x93\x1b\xa0\x8a\x51\xa9\x6c\x9f\x6b\x7e\xe4\x9e\xbe\x59\xf3\x5b\x76\x2f\x15\xe5\xf4\xb7\xb9\xe5\x15\xbc\xdd\xe5\x96\x17\x71\x2f\x98\x3c\xba\x0b\x93\xfe\x8c\xa6\xb5\xaf\x4c\x75\x94\xa9\xf2\xb3\xb9\x61\xf4\xef\x59\xfe\x69\x6f\xd6\x76\x27\x3f\xd6\xb0\x37\xe2\x4b\xb1\x17\x25\x28\x5a\x28\xbc\x56\x37\xdc\xab\x47\x6b\x34\x7c\x33\xf0\xc3\x07\x67\x78\x0a\x8b\x32\x2c\xb2\x19\xf6\x41\x08\x0e\xb5\xad\x57\xc1\x1b\xc0\xf8\x58\x1a\xb1\x07\xe6\xe9\xdd\x84\x03\xb4\x74\x86\x3e\xca\xc3\xb4\xed\x1d\xef\xd6\xdd\xee\x57\xad\x45\x88\x9a\xa3\x77\x95\x5c\x4e\x28\xda\xa9\x31\x9a\x9e\x27\x51\xff\x30\xbb\x95\x82\xdd\x62\x8e\xd3\x3e\x3e\xf2\x55\x5a\x84\x79\xbe\x69\x9a\x21\x23\x34\xd4\x2d\xdf\xd4\xad\x90\x99\x06\xa3\x4e\xc4\xbc\x28\x00\xd5\x37\x06\x9d\xcf\x1c\x74\x5f\xb4\x7b\x56\xd5\x38\xa0\xa6\xa1\xf9\x8e\x11\x91\xd8\x8a\x4e\xdb\x95\xd1\x6b\x6e\xd9\x16\x3e\xfa\x8c\xb0\xc3\x04\x27\x19\x60\x3d\x5c\x65\xfe\x7d\x5b\x94\xc9\x1a\x83\x25\x44\xe6\xfd\x97\xc0\x95\x8f\xc3\xcf\xb0\xc2\x23\xf7\x8a\x1e\x91\xcb\x3c\xde\x9d\xf9\xe3\xe5\xd5\x99\x11\x18\xcd\x00\x33\x69\x82\xb9\x2f\x2a\x9f\xe6\x1c\x5b\x8f\xf3\x9a\x63\xbc\xd0\x90\xa8\x32\x22\x21\x9f\x89\xa2\x43\xc5\xb2\xea\x2b\xc6\x4b\x2c\x10\x25\x9f\xe8\x49\xbc\x45\xf5\x9d\xa3\xfa\x8d\x66\xfc\x86\xfb\xf1\xc3\x77\x3f\xc0\xd3\xa2\xac\xdd\x47\x9d\xdb\xee\xf3\x5e\xb0\xcf\x89\xfd\x1d\x87\x8b\xb5\x0e\x5c\x33\x4c\xbf\x4b\xd7\x0f\x69\x2e\x09\x56\x04\x80\xcb\x24\xfa\xf3\x23\x0f\x6b\x44\x2e\xae\x8f\xe8\xcf\x47\x45\x86\x24\x85\xf5\xad\x6a\x44\x90\xe7\x3c\x2d\xb0\x77\x62\x04\x8f\x0a\x90\x8c\x10\xac\x77\x93\x43\x86\x95\x08\x6b\x88\xc6\xcc\xab\xed\xed\xba\x29\x97\xd9\xb7\x4a\x19\x9b\x9d\x39\x4b\x4d\x88\xdc\x4c\x51\xca\xbd\x21\xa2\x9d\x99\x28\x53\x34\x48\xc7\x63\x6a\x67\x68\x47\x4e\x04\x7a\xa4\x45\xb8\x23\xee\xf4\xd9\x2a\x3a\x3d\x3d\x44\xb4\x4f\x17\x15\x5d\x8a\x29\xd2\xc8\xe2\xb8\x60\x3b\xc5\x18\x0f\xa0\xd8\xa4\xf9\x50\x8c\x8c\x11\x07\xa2\xfc\x24\x95\x7d\xed\x35\x35\xb4\x71\xb5\x6b\x84\xb3\x12\x70\xba\xdb\xf4\x22\xc4\x99\x9b\xb4\x71\x56\x5e\xe9\x4d\x68\x74\xfb\x27\x57\x4c\x27\x3c\xec\x04\xce\x02\x33\xb4\xea\x14\x0b\xe9\x4a\xe7\x71\x2c\x98\xbe\x05\x9b\xc4\x8b\x3c\x65\xdb\x82\xd7\x42\xa8\x2e\xc8\x99\x28\x17\x5a\x2d\xa1\xca\xc5\x98\x55\xb1\xaf\x75\xa7\x66\x11\xce\x80\x67\x3e\xe3\x05\x93\xe4\x96\x3f\xd0\xb8\x96\x70\xcf\x15\x2b\x98\x52\xc1\x0f\x35\xdd\xfb\x6c\xab\xa5\x0c\x73\x7e\xf9\x90\xfc\xe8\x0a\x5e\x2e\x0f\x81\xa3\x73\x51\xe8\xa8\x1e\x67\xb1\x58\xd4\x7f\xff\x45\x59\xf5\x0b\x99\x59\xf2\xe2\xa2\xf5\x18\x7f\xe0\xb8\x01\xcf\xf5\x59\xfb\x07\x7e\x6a\x2f\xf0\x94\xb5\x56\x27\x8a\x7f\x9f\xf4\xff\xa6\x4e\xcb\x7d\xc4\x61\x86\x35\x79\x51\x8b\x90\x0e\xb9\x8d\x08\xae\x16\x78\x58\x68\xb2\xdb\xaa\xa8\x68\x7a\x2d\x43\x9c\x78\x85\xe9\x79\x7b\x4f\x24\xdc\xda\x02\x4d\xcf\x8b\x6a\x47\x68\x86\xcd\xcb\xf9\xbe\x00\x2e\x51\x10\xec\x60\x30\x18\x88\x97\xaf\x6a\x15\xc6\xa3\x8c\x6d\xe4\x2f\x33\x6d\x51\x1d\x7a\x22\x82\x87\xb8\x35\x15\x47\x58\x08\xc8\xea\x3e\x87\x09\xb6\x4d\x8f\x01\x25\xf8\x21\x62\x81\x59\x51\xbb\xea\x76\x99\xac\xd4\xce\x62\xb2\x2f\xf0\x5c\xa5\xf4\x77\x4d\x9d\xb8\x61\x3a\xc7\xa8\x9f\x03\x33\xeb\xbb\xa1\xa5\x5c\xbc\xc0\x04\xef\x21\x0a\xe9\xbe\x3c\x41\x12\x94\xc5\x49\x2a\x1d\xf7\x3c\x28\x09\xcb\xdc\x8a\x0a\x21\xa2\xc9\x53\xb6\x98\xb7\x69\x88\x0f\xbe\x90\xfe\x22\x35\xe3\x07\xab\xe2\x02\x44\xed\x9f\xea\x84\x8b\xba\xdc\x23\xdf\x75\x31\x48\x7b\xe4\xe6\xf4\x60\xfa\xe3\x48\x08\xfa\xc9\xc0\xf0\x43\x81\xb3\x87\x0c\x2e\xd4\xc5\x93\x69\xf2\x56\xf7\x57\x94\xa5\x86\xe5\x0b\x8a\x86\x49\x05\x11\x3f\x4c\xc3\xfc\xcb\x3e\x05\xe3\x81\xc1\xd3\x17\x7c\x37\x5f\x74\xa8\x18\x77\x91\x13\x71\xe7\x79\x99\xbd\xb8\xe8\x76\x38\x7e\x88\xb2\x2b\x7a\xce\x94\x75\x70\x13\x9d\x38\x64\x60\x14\x55\x80\x1b\x1f\x59\x59\x91\x20\x5e\xc0\x00\x0c\x18\xe0\x85\xe2\xab\xfa\xb3\x7c\x94\x01\x0c\xe0\x66\xde\x6f\x65\x39\xe7\x3d\x23\x59\xa6\xab\xfb\x21\x99\xfd\x90\xbf\x67\x65\x27\xa6\x44\x7f\xfc\x10\xc6\xe3\x87\x30\x1f\x3f\x84\xf5\xf8\x21\xec\x47\x0c\x31\xd6\xa2\xb8\x2a\xcd\xdd\x60\x3e\x56\xa9\xe0\x5e\x82\xb9\xf6\x1a\xa3\xcf\x13\xb6\xa2\xa2\x32\xec\x3f\xb3\x24\xad\x4a\xa3\x2d\x00\x69\xe0\x9a\xde\x60\xb2\x66\x96\xcf\x2b\x64\xe2\x6f\xf3\x97\x93\xeb\x34\xcb\x9b\xae\xe7\xb2\x50\xb7\xf8\xbd\x29\xc6\x0d\x60\x02\xeb\xe6\xea\x14\x2f\x6f\x84\x8d\x9c\xd1\x92\xd0\x94\xe8\xd6\x5e\xc2\x3d\xb5\x46\x99\x16\xfb\x1c\xbc\x9a\x2a\xd8\xbd\xe3\xa5\x2b\x71\x13\x89\x73\xba\x0e\x90\xed\xb8\x6f\x5d\xc7\x33\x5d\xcf\x0b\x5a\x14\xfc\x42\xa0\xa6\x18\x81\xd2\xd8\x74\x4c\x42\x8d\x90\x99\x91\x1f\x84\x6e\x10\x99\xa1\xee\xfa\x71\x64\x79\x3e\x25\x24\x70\xcc\x90\x78\xb1\xe1\x5a\x91\x4d\x0c\x03\x13\xab\x1c\x87\xd8\x34\x76\x4c\x2b\xb4\x58\xfc\xe2\x01\xfa\xae\x96\x2a\x3c\x37\xb2\x09\x86\x28\x47\xac\xdf\x31\x27\xa0\xb6\xe7\x90\x90\xb9\x81\x13\x79\xb1\xeb\x11\x9f\x98\x16\x86\xaf\x59\xc4\x77\xdc\x50\x07\x11\x1e\x34\x47\x71\x63\x88\x93\x13\xc0\x2f\x34\xf6\xf3\x16\x04\x72\x1c\xe5\xb1\x4b\x58\xcc\xf7\xd9\xf5\x9f\xf6\xda\x76\xdc\xe2\x5d\x95\xf4\x17\xff\xf8\xcd\x4f\x69\x51\x39\xa8\x16\x03\x07\xd6\x60\xab\x56\xde\x66\xb5\xdd\xb6\xee\xcb\xd1\xb3\x5c\x54\x6c\x74\x2f\x4c\xed\x32\x50\x6e\xa6\x78\xe4\xf2\x7b\x2c\x75\xaa\x86\xd6\x61\x36\x15\x59\xed\xab\xe6\x5c\xd5\x2e\x00\x6f\x53\xbb\x31\x9c\x0d\x4b\x66\x67\x07\x06\x1c\x36\xd7\x9a\x90\x13\xa7\xa3\x60\x15\x19\xf2\x21\x1e\xac\x88\x9d\x4a\x80\xcb\xa6\x57\xde\xe3\xe1\x31\xa4\x92\x7a\xda\x63\xda\xef\x87\xf4\xd2\x63\x38\x72\xab\x1b\x5e\x4d\x2e\xe9\x84\x25\x4e\xe9\xb5\x95\xce\x25\x1b\xf8\xb4\x8b\x89\x2f\x48\x11\x2d\x0e\x93\xb3\xe1\xcb\x6e\x89\x29\xa6\x3c\x22\x61\xb2\x23\x84\x70\xcb\x88\x40\xd8\xd7\xdf\x5c\x82\xac\x44\xae\xd7\xdc\x96\xc9\x2b\x76\xdf\x2e\xb3\x15\x6b\x02\x30\xe0\x0d\xae\x6b\x72\xe3\x89\xd4\x36\x25\x61\x4b\x4a\xc6\x31\x14\x8d\xb2\x2d\xd1\xb5\x39\x2e\xee\xf7\xb6\x54\x4b\x45\x09\x30\x30\xf1\x46\x66\x36\xbe\x24\x69\x96\xde\xaf\x51\xcf\xad\xf8\xc7\x9d\xa8\xf3\xfb\xaa\x51\xce\x2a\x7b\x81\x7c\x03\x67\x97\xce\x5b\x55\x66\xeb\x10\x8e\x4a\x25\xb2\x9c\xf6\xd0\x4f\x43\xa1\x39\x23\x81\x39\x23\x63\xf5\xb8\x98\xd8\x72\xb9\xac\xae\x19\x88\xc7\xb2\xf3\x42\xf8\x1f\xba\xfd\xfa\x9a\x39\xda\x7d\x37\x85\xc5\xb2\x1b\x29\x26\x16\xc1\xab\x4a\xf4\xea\x04\xb7\x27\xfa\x88\xdb\x3f\x16\x99\xd4\x6b\x57\xbf\xc7\xb8\xad\x26\x1a\x7b\x8d\xda\xdf\x13\x65\x58\x2e\x08\x8d\x8c\x2c\xbd\xf2\xed\xf8\x8e\x7d\xed\x86\xbf\xf6\x4e\xa3\x42\x6e\x81\x9f\x58\x04\x03\xa8\x6b\x21\x83\x9e\x24\xfa\xcd\xb5\x77\xb2\x2a\xb3\xe8\xa0\x44\xf2\xeb\x82\xdb\x02\xc4\xbb\xbc\x67\x14\x50\x7d\x02\xb8\xd0\xee\x78\xdc\xd2\xd4\x7a\xa7\x8e\xc3\xf4\x17\xd0\xc3\xb2\x89\x05\xdc\xd4\x8d\x5b\xe4\xfe\xe2\x98\xdb\x35\xa7\x95\xd6\x0a\x66\xda\x27\x26\x5b\x3b\x54\x6f\xf0\xd5\xb7\x35\x1f\x2e\xb8\xf2\x31\x9f\x48\x70\x6d\xcd\x76\x29\xb4\x5d\x41\xd5\x09\x26\x88\x44\xdc\xc8\x0d\x73\x2c\xd9\xdd\xac\xd3\x48\x4b\xa4\x5c\xc9\x57\xe1\x77\xb5\x6b\xcd\x42\x1c\xba\x34\x16\xf0\x37\x17\x7c\x37\xc5\x07\x98\xf5\x8b\x6b\x67\x71\x56\x71\xaf\x56\x9d\x71\x1e\xb2\xce\x6d\x5f\x98\x73\x03\xdc\x30\xa9\x78\x8d\xaa\x07\x60\x95\xf0\x56\x83\x9f\x85\x72\x3b\x2d\xb8\x94\xda\xd6\x14\xd4\x52\xdd\x94\xf2\xf2\x2b\x64\x75\x35\x12\xbc\x39\xc0\xc2\x46\x25\x80\xf6\x8f\x03\x9d\xac\x9a\x1f\xfb\x36\xea\x87\x38\xe0\x44\x70\xe2\x03\x26\xd1\xd6\x17\x1f\xa5\xd7\x69\x77\x17\x0f\xff\xfc\x8d\x60\xee\x0f\xe6\x8f\x1c\x44\xfc\x3d\x72\xff\x4c\x54\x5a\x85\x1b\x35\xb4\x59\x13\x23\x07\xe9\x25\x60\x7c\x5d\xa0\x07\xb8\xca\x36\xc5\xc7\xf4\xd5\x7c\x94\x44\xc4\x3a\x1f\x24\x91\x0e\xb9\x75\x39\x04\x6c\x05\xbd\x87\xa9\x92\x48\x21\x16\x7e\xef\x73\x8a\x91\xbd\x05\x65\xcf\x87\x42\x4b\x8a\xf9\x83\xa7\xce\x8d\x66\x78\xee\xbb\xaa\x6b\xa7\x8f\xc4\x9a\xd6\xd7\x95\xb3\xd2\x98\x2a\x72\x5c\x4b\xe0\xd5\x49\xef\x62\xe5\xd9\xa3\x66\x95\xea\x21\xd8\x7f\x17\x76\x4e\x8b\x79\xdc\x34\xfb\x64\xb9\x1c\x96\x2f\xd5\xda\xe2\xaf\x1a\x87\x1a\xd1\xf9\xfc\x94\x0e\xfe\x7f\x78\x75\x65\x05\xcb\xb1\xda\x6f\xf1\x44\xc9\x7d\xd8\x3b\x04\x6e\x68\x74\x7c\x0c\x88\x92\xad\xfc\x49\xdd\xf3\x42\x3b\x30\x42\xcb\x71\x18\xa8\xde\xb6\x1f\x61\x94\x92\x45\xdc\x38\x02\x5a\x30\x18\x63\xc4\xf3\x62\xd2\x8a\x80\xc2\xf4\xc0\x9d\xe2\x67\x87\x2b\x4b\x4a\xa7\x4b\x35\xd0\xa0\x7b\x5b\xb1\x68\xaf\x79\x97\xad\xc3\xa7\x2b\x56\x19\x1c\xaa\x18\x65\x70\xae\xc6\x77\x0a\x3b\xf6\x9e\xb1\x74\xef\xb9\x9a\xc2\xb9\xaa\x1f\x52\xd8\xd0\xa7\x96\x69\x38\x96\x6e\xb8\xb6\xe7\xea\x2d\x18\xfe\x7a\xd8\x8a\x87\xa1\xc0\xe5\x4f\xac\x5e\x80\x80\xff\xad\xb2\xd4\xea\xe6\x6b\x63\x19\x87\x3f\xef\x0d\x59\x81\x81\x62\xe8\x91\x93\xe5\xe4\x24\x7c\xbc\x89\xd8\x0c\x1d\x0a\x39\x9c\x10\xd7\x71\xf5\xe1\xfa\xa1\x87\x55\x14\xdb\xa9\xc2\x31\x07\x02\xae\xea\xcd\x86\xa5\x3b\x9f\x52\xb6\xa2\xdf\x31\x42\x0f\xa1\x4d\xa5\xd7\x8f\x10\xa5\x27\x0a\xce\x5b\x24\x0c\x63\xac\x7d\xef\x78\x16\xd3\x23\x07\x4b\xb1\xd9\x26\x80\x02\xc7\xc5\xe0\x37\x66\xd8\x3a\xf1\x3d\x16\x87\x4c\x8f\x63\x12\xfa\x2c\xf6\x03\x27\xf4\x5c\xdf\x65\x6a\x3f\x82\xdb\x23\x00\xcb\x5d\xe5\x0f\xc0\xea\xc7\xb1\x45\x3d\xc6\x4c\xfc\x6b\x68\x85\xc0\x3f\xbc\xc8\x67\x2e\x33\xa8\x11\xd2\x10\x6e\x36\x33\x26\x36\xc2\x6a\x12\x93\x39\x11\x0d\x5d\xe2\x84\x46\xac\x56\xb5\x5d\xaf\xb3\xf4\x35\x2f\x97\x75\x58\xe1\x0f\x2c\x46\x50\x03\x5d\x2c\x49\x2e\x44\xc4\x30\x2b\x97\xd3\xd0\x13\x1a\x19\xd4\x0d\x01\xb2\xd8\x0d\x89\x1e\xdb\x36\xa1\x06\x0b\xe0\x92\x36\x22\x8b\x52\x27\xd4\x23\x17\xa0\x76\xa9\x11\xeb\x61\x00\x6f\x5a\xcc\x8b\x8d\xc8\x6c\x5d\x40\x9b\x25\x49\x87\x48\xb7\x7b\xe7\xf5\xa3\x75\x6b\xfb\x10\xe0\x96\x2c\xbe\xdb\x34\x3e\x12\xdb\x22\xcb\x88\x61\x00\x60\x82\xb2\x64\x24\xfb\xb1\xf2\xbb\xec\xa0\xa4\xe9\x7a\x1b\x7e\x3a\x06\xc6\xfd\x43\x76\x60\x58\x65\x93\x5a\xc7\x5e\x34\x3c\x45\x85\x1b\x79\x95\x1e\xe9\x8a\xe4\xed\x8d\xe8\x76\x35\x5a\x7e\x68\xff\xcb\x12\x33\x2f\x6f\x1e\xd7\xd5\xa7\x5a\x24\x4f\x00\xe4\xc3\x55\x2a\xb8\xb8\x59\xaa\x96\xb2\xb2\xfd\xb6\xc0\x21\x64\xfa\x33\xde\x25\x3b\x95\xdf\x60\xcd\x9e\x6a\x84\xaa\xdd\x6c\x33\x00\x19\x2f\x77\xd5\x14\x51\xbe\x62\x3b\x48\x2d\xdc\x04\xb5\x8f\x34\x5c\x2e\xb3\xfc\xfc\xc6\x98\xeb\x73\xfd\xcc\x75\x7d\x20\x2d\xff\x8c\xb2\x9b\xf3\x55\x92\x6e\xef\xce\xaf\x33\x63\x6e\xe8\x73\x4b\x29\x61\x8c\xbd\xbe\x76\x2e\xbc\xdc\xa5\x73\x1f\x84\x6b\x62\x53\x3b\xa2\x40\xba\x91\x63\x52\x10\xeb\x03\x4f\xb7\x63\x3b\x32\xfc\x58\x37\x75\x66\x84\xb6\x4f\x81\x08\x6c\x10\xfd\x81\xfe\x99\x1d\x1b\x31\x71\xe2\x38\xb0\x4f\x0f\xac\x16\x58\xc3\xe0\xfa\x76\xe0\x35\xd8\x0b\xdb\xb9\xe7\x1a\x1c\x00\xcf\x34\x89\xa3\x3b\x8c\x61\x59\x53\xdb\xb2\x0c\xdd\xf5\x49\x14\x53\x1f\xeb\x74\x78\x84\x3a\x7e\x6c\xbb\x16\xb0\x2f\x12\x06\x84\x00\xa3\x8d\x0c\x66\x87\x26\x33\x29\x7c\xc8\x40\xc3\x88\x0c\x3b\xa6\x04\x8b\x76\x12\xea\xd9\x21\xb5\x62\x57\x77\x02\xdb\x05\x76\x47\x2c\x27\x72\x7c\x3f\x0e\x22\xe2\x86\xcc\xb2\x6c\x83\x99\x11\x33\x7c\xd0\x4f\x6c\xc3\x02\x45\x48\xbd\x53\x78\x7a\xec\x5e\xd0\x1b\xa6\x3f\x37\xe6\x56\x30\x37\x4c\xfd\xc2\x30\x4c\x4b\x49\x33\x4b\xd2\x10\xe4\xb5\xc7\x84\x07\xd2\xed\xee\xa9\x1a\x8d\xec\x27\x23\x5f\x3f\xfc\x4f\x73\x12\x07\xd5\xf6\xea\xe9\xf1\xf0\xc5\xf1\xea\x50\x34\xff\x57\x35\xfe\x9e\x8c\x40\xec\xbc\xb3\x33\xdb\xf9\xb5\x63\x31\xa7\xd8\x5b\x8e\x15\x03\x95\xd7\xd1\xdd\x41\x92\x54\xf0\x1e\x60\x2b\x3c\x11\x55\x76\xf5\x0e\x41\x3f\x8b\x96\x92\xa1\x54\xfa\x6b\xdd\x38\xf4\x18\xbc\x63\x40\xeb\xb2\xd1\x6a\xd3\x0d\xb3\x4a\xae\x73\xb2\xee\x3c\x6c\x25\xc8\x8a\x47\xec\x66\x4d\x93\xa2\xf3\x30\xcd\xb2\x4d\xe7\x51\xb6\xe1\x3a\x45\xb7\xc3\x49\xce\xba\x05\x1d\xb9\x69\x30\x1f\x9a\x1d\x84\xd0\xce\xd3\x5d\xec\xea\x7c\xfb\xe6\xda\xdb\xf5\xa6\x94\xb6\x2e\x25\x06\xa7\x8a\xc4\x82\x6d\xda\x46\x3c\xf8\xf1\x9a\xe5\xd5\x37\x43\x38\xff\x42\x71\xf8\x92\xfc\x9a\x3d\xd2\xfa\x2f\x83\xcd\xe2\x84\x61\xec\x5e\x29\x0a\x43\xf2\x71\x9b\x94\xe7\xa8\xed\x68\xd2\xb4\x6f\x45\x65\xa3\xd5\xbd\xf4\x90\x35\x39\xee\x75\x01\xcf\xb9\xf6\x27\x11\xb5\x35\x10\xb1\x76\xf9\xe6\xfc\x65\x79\xc7\x4d\x71\xbf\xc2\x3f\xe9\xab\x73\xa5\xe2\xf8\x62\x9c\xfd\x53\x90\x60\x6c\xea\xc6\x20\xc2\xe8\xc0\xfd\xe0\xbf\x11\xd5\x99\xee\x11\x20\x51\x3d\x74\x6c\x97\x86\x3a\x16\x16\xf3\xdd\x80\x3a\x51\x14\xea\x94\x9a\xc4\x70\x99\xe7\x80\x8c\x73\xae\x9f\xeb\xed\x1e\x53\x4a\x77\xd4\x27\x50\xe4\x3b\x2e\xc8\x5e\x1d\x8e\xb1\xda\x94\xb6\x6b\x7a\xba\x85\x59\x03\x81\xc3\x42\x0f\x24\x54\x60\xe4\xba\x63\x53\x42\x5c\xcb\xf1\xbc\x48\x77\x4d\x5b\x6d\xa1\xf6\x89\xdd\xbf\x47\x15\xec\xf3\x76\xc4\x52\x6c\x89\x6b\x72\xd7\x4e\x39\x68\x20\xe8\x99\xe5\x87\xc2\x96\x77\x46\xe3\x0e\xf8\x0c\x68\x27\xb4\x6d\xac\x54\x0b\x77\x9e\x67\xc6\x91\x19\xc2\x4d\x18\xf8\x3a\x8b\x1d\x83\xfa\xd4\xd4\xfd\x30\x24\x20\x2f\x58\x31\x8d\x62\x10\x87\x3d\x6a\xfb\xb6\x47\x22\xd0\x03\x46\xd0\x61\x92\xbf\xb1\xbb\xf2\x2f\xec\x7e\x0f\x40\xdb\xfc\xa0\x55\xa0\xb0\xdd\xe6\x6c\x5f\xf7\x2a\x6c\x80\x65\x31\xdb\xb4\x60\xb1\x51\x10\x5a\x1e\x05\x69\x36\xa4\x78\xef\x84\x14\x44\x1f\xc2\xc2\xc0\x31\x60\x2f\x4c\x53\xb7\x1d\x5b\x77\x00\xe9\x22\x13\x44\x0b\x1f\x08\x26\x0e\x60\x8f\xfc\xd3\xae\x73\xe3\x13\x1b\xe8\xf0\x77\x94\xd6\x69\xed\x21\x7b\xe5\x18\x8e\x34\x53\x54\x15\x5e\xea\xb5\x70\x9d\xb6\x69\x17\x7b\xaa\x7b\x39\xb9\xe5\xd5\x89\xab\xbe\x01\xd8\x74\x6a\x06\x48\xfc\x49\x38\x08\x44\x30\x32\xad\x22\xd2\x85\xe3\x80\xab\x78\x33\xad\xf1\x10\xea\x3a\x5c\x08\x75\xd7\x2d\x3e\x9e\xfc\x00\xbb\x49\x71\x1f\x84\x6c\x67\x95\xc4\x98\x08\xdc\xf6\xd3\x1c\x5a\x56\xeb\xf7\x5a\x6e\x4d\x49\x8c\xda\xb9\x44\x7c\xbb\x41\xc4\x0d\x83\x63\x83\x47\x4a\x35\x78\xb5\x5b\x4e\xd5\x2a\xe7\x5f\x2c\xcf\x66\xe2\x77\x11\xb3\xde\xc4\xe0\x02\x5a\x27\xb4\x0e\xab\xed\x35\x96\xee\xc4\x42\x77\x11\x75\x0a\x45\xf7\x68\x24\xf0\x44\x16\xa8\x9d\x7b\x53\x28\xea\xbe\x6e\x39\xc7\x37\x19\xec\x73\xc0\x9d\xe0\xf0\x76\x9e\xf1\xae\x25\x24\x46\x0b\x3f\xec\x55\x9d\xbb\x55\x5f\x0c\x2d\x51\x70\x55\x99\x7a\xc4\x58\xe4\x60\xe7\x85\xc8\x35\x23\x50\x6a\x8d\xc0\xa2\x96\xcf\x6c\x16\x12\xdb\x67\xbe\x6f\x38\x9e\x19\x44\x20\xbd\xc0\xed\xa6\x93\x10\xe8\x06\x5e\xd5\x4f\x1f\xc1\xba\xea\x1e\xe2\x92\xdf\xec\x60\x79\x1a\xaf\xb3\xd4\x56\xaa\x1e\xe0\x42\xbd\x5d\x38\x5a\x81\xbf\xca\x74\xd7\x75\x4c\xee\x09\xd0\x61\xce\xd6\x5e\xba\xeb\x34\x36\x4d\xe2\xd4\x28\x6e\x3e\x7a\xcc\xa1\xec\xbd\x69\xbd\x72\x52\x55\x97\xed\xde\xf7\xc4\xbd\xb2\xd3\xc7\xfe\x37\x41\xbb\xe3\x95\xdf\xae\x76\x96\x0c\xf4\x6d\xdf\x19\xa0\xf2\x8e\x6f\x09\xa1\xd9\x46\xc8\x01\x69\x76\x5b\x17\x2b\xbf\x82\x53\x69\xf7\x14\x1d\x2e\x3d\x5f\x92\xd5\x5e\x9c\xcc\xea\xa4\xe9\xf2\x56\xc5\x7b\xf1\x53\xb5\x28\xed\xdb\xc3\xc6\x50\xbc\x76\x59\xb4\xdb\x02\x46\xfa\xdc\xdd\xa1\xd2\x19\x36\x41\xd7\xaf\xaf\x2e\xeb\xfe\x4e\xe9\x48\x1b\x75\x43\x61\xe4\xeb\xac\x64\x8f\x9b\x5e\x96\xb9\x94\x61\x9e\x68\x17\x2c\x1e\x58\x32\x5e\x61\x57\x2c\x97\x2d\xdc\xf7\x9e\x1d\xb3\xe2\x5b\x10\x60\xcd\x5d\x0c\xcc\x9a\x50\x2d\x0d\x57\xb1\x6a\x8b\x4e\x16\x7b\x12\x70\x6b\xc6\x0d\xba\x01\x39\xcf\x9d\x89\x5b\x44\x6c\xbe\x78\x87\xef\xc3\x32\xb9\x5e\xa2\x84\xb4\xca\x6e\x0f\x25\x75\x72\x50\x05\xbf\x63\x70\xf5\xde\xb9\xec\xc7\x83\xa5\x78\x07\x04\x7c\x84\x9e\x03\x4f\x73\x6d\x66\x87\x44\xf2\xec\xbf\x9d\xfb\x54\xcd\x18\x2e\x70\xb8\x47\x0d\x9d\x21\xf3\x06\x01\x29\x37\x8a\x28\x3d\xb4\xd0\xbc\xd2\x27\xed\xe0\x82\x1b\x8a\x27\xdc\xdf\xb7\x4e\xc6\x11\x2a\x9a\x0f\xef\xeb\x08\xdf\xdd\xd1\xb5\xb5\x37\xcb\x6d\x8b\x10\xa8\x10\xbc\xa6\xf4\x80\x28\x89\xa1\x38\x00\x82\x23\xe1\x87\xd9\x64\x3f\xe6\x81\x9e\x62\xa8\xf7\x91\x15\x56\x85\x38\xa8\x42\x57\x53\x5c\x41\x48\x35\x68\x50\x57\x6a\x60\xcd\x94\x84\xe9\x88\x60\xb2\x74\x88\x31\x97\x64\xb5\x25\xe5\xa8\x97\x12\x65\x0e\x46\xec\xc8\xf5\x5b\x46\xb6\xa9\xbb\x7a\x67\x27\xcb\x61\x6d\xb0\xdb\x96\x4c\xa5\x1b\x76\x22\x24\xba\x06\xb4\x99\xc6\xb8\x91\x1b\xd6\xdb\x3c\x9c\x6b\x97\x98\xc8\x8d\x1e\xcc\x2c\x6e\x0d\xf5\x47\x6d\x51\xe3\x31\x1f\x69\x8d\x31\xc4\xd5\xe0\xa5\x52\x2c\xbf\x9a\xea\x9e\x95\x43\x3d\xc9\x61\xa0\x78\x8b\x09\x15\x35\xb7\x58\x28\xba\x7b\xce\x62\xee\x3b\x5d\x32\x42\x6b\xad\xbe\x0e\x32\x38\xe9\xc4\x95\x49\x40\x45\x72\x6c\x86\xb5\x67\x78\x90\xa9\x08\xe7\x8e\xb8\x9d\x5b\x42\x28\x0c\x40\xb7\x22\xae\x3a\xdb\x96\x5d\x90\x92\xb4\xd8\xd6\x1d\x17\x44\x31\xe3\x45\xf7\x1d\xc4\xa0\x32\x83\x5b\x12\x6d\xa0\xbd\x5f\x39\xab\x62\xb4\xf7\xbc\x5a\x65\xd5\x62\xbc\xf2\x7c\x2f\xc6\xb7\xb7\xd2\x01\x7a\xaf\x88\xed\x1c\xb4\xba\xf7\x0e\xa7\x72\x24\xf3\xb8\x85\xcf\xd0\x4e\xe7\xee\xc9\xaf\xc1\xfd\xc8\x6a\xd4\xcb\x2f\x88\x41\x25\x84\x86\xf2\xb7\xe9\x27\x10\xeb\x53\x59\xd4\xa0\x0e\x5e\x90\x18\x5d\xdc\xa7\xd1\x44\xfb\xe3\x46\xd7\xbf\x98\x0a\x87\xe0\x59\xdc\xe5\x9d\x74\xc7\xa0\x4f\x8b\xe7\x71\x60\xfd\x00\x01\x46\xa1\xb5\x1b\xa8\x01\xd3\xeb\x27\x0b\x4d\x86\x6d\x56\x5d\x94\xbe\x61\xa4\xfc\xda\xc8\x74\x4c\xe6\x38\x52\x23\xd3\xaf\xbd\x43\x47\x4f\xe1\xc0\xc6\xd0\x5f\x56\xb3\x42\x58\xca\x50\x5d\x8d\xd1\xc3\x5d\xb2\xbb\xdd\xdd\xb9\x7c\xf0\x2a\xbd\x9f\x93\x72\xc1\xcd\xd5\x3c\x81\x87\x80\x7c\x1e\xe1\xbf\xd5\x59\x13\x4f\xe4\x1f\xfc\xfa\xe7\x79\xff\x51\x1c\xcc\xc7\x23\x99\x3e\xb2\x36\x21\xc5\xbc\x2d\x65\xbc\x4d\x65\x37\x53\xf4\xa4\xa8\x98\x3c\xc8\xf2\x95\x64\x96\xfa\x72\x32\xbf\xde\x4e\x5f\x6f\xa7\xaf\xb7\xd3\x23\x6e\xa7\xa3\x74\xda\x3e\x8a\xfb\x6d\xdf\xc2\x93\xe5\xdd\xb7\xbb\x5a\x54\x77\xdb\xc5\x96\xd1\x53\x6d\xb0\x3c\x6d\x56\xde\xb9\xcb\xed\x61\x6d\x69\x9b\x60\xda\x7d\xfa\x8a\x3f\x6e\xae\x5a\x5e\xb8\x98\xa0\x80\x4c\xf4\x7f\xe3\x59\xe4\xa2\x10\x0a\xa6\x66\xd5\x75\x79\x45\x86\x66\x5d\x5b\xb7\x78\x8c\x42\xf2\x0d\xce\x36\xd5\x91\x6c\x0a\x36\xf1\xf6\x31\xa7\x1f\xea\x80\x30\x0c\x41\xf5\xaa\xcc\x72\x14\x7b\x52\xa7\x22\x1e\x15\xa8\xbb\xe3\x57\x6e\xe6\x37\x6c\x4b\x19\x34\x8f\x60\x08\xd8\xf1\x9a\x40\x35\xfb\xa1\x6b\xee\x78\xb6\x81\x9e\x90\x3e\x7c\x9e\xd8\x20\x9e\x6f\x62\x63\xb6\x98\x29\xf6\xc0\x51\x62\x98\x35\x84\x30\x6b\xa1\xa5\xa8\xfb\x51\x61\x09\x5c\x8d\xb8\xa1\x47\x40\x0b\x71\x62\xea\xa2\x86\xce\x0b\x2f\x88\x83\x35\x93\xd6\x6d\x6d\x9b\x55\xb5\x73\xe3\xb9\x08\x94\x86\x25\x76\xe9\xb2\xf8\x80\x29\x3c\x93\x78\xdd\x7e\xe5\x20\xb3\x91\x94\xdc\xb8\x85\x13\x74\x33\x9e\x36\xa4\xe4\x52\xa5\x71\x82\x7b\xd0\x4d\xe8\x3d\xd6\x8d\xc6\x93\x94\xaa\xe4\x25\xec\x70\xb4\x69\xc5\x3c\xcd\x34\xbd\x0a\x7b\xca\x52\xd9\x8d\x4c\x85\x8f\x77\x8b\x4d\xfe\x75\x40\x93\xb3\xae\x41\x79\x60\x43\xea\xc1\x81\x94\xe6\x6c\xae\xad\x31\x63\xa6\x5c\x92\x54\x33\xcf\x2d\x11\x45\x2b\x72\x69\xaa\x24\x4d\x9e\x64\x53\xc0\x99\xdf\xb0\x3a\x67\xb3\xbf\xb8\x84\x9b\x30\x9b\xe2\xae\x02\x74\xd9\x29\xec\x32\xbd\x22\xe5\xb2\x5a\x8d\x28\xc6\xd2\x4e\xbf\x4d\xb8\xa8\x5a\x67\xa2\x3d\xd0\x69\xef\xa4\x32\xba\x8b\xaa\x29\x2d\x8b\x9e\x20\x4d\xc5\x8d\x3f\x44\x65\x2d\xae\x58\xb7\x21\x3e\xac\x77\x62\xd5\xf8\xf5\x32\xfd\xef\x2d\x6b\xc4\x05\xb1\xca\x9c\xdc\x2a\x2b\xfc\x19\x5f\x38\x99\x40\xdd\x9c\x01\x9c\xc0\xb1\x34\x22\x82\x21\x9b\x26\x75\xf3\xde\x9a\x55\x07\xc4\xf0\xa2\x2b\x64\x91\xf9\xab\x37\x09\x36\xb9\x18\x06\x53\xfe\xb8\x0b\xac\xb2\x74\x4b\x4b\x3f\x03\x06\x70\xf9\x86\x97\x6f\x39\xad\xf1\xeb\xb4\x0e\xd9\x93\x99\x87\xf5\x2f\xe2\xdb\xb9\x9a\x44\x89\x5e\x84\x42\xf4\x23\x06\xca\xc8\x84\x07\x6c\xbe\xcb\x91\x76\x16\xd7\x47\xb4\x81\xb5\x8d\x61\xda\xaf\x6d\xdf\x28\x6f\x45\x9c\xd7\x85\x5f\x71\x75\x08\xf2\xa9\x9a\x65\xa0\x66\x55\xee\xb9\x01\x8f\xc4\xe2\xa6\x10\x2e\x8c\x2d\xb6\x01\xb3\x59\x07\xcf\x17\x1d\x34\xbb\x9c\xad\xe8\xbd\xcc\xdd\x39\x3b\x9e\xd1\xce\x47\x24\x63\xc1\xff\xc2\xee\xdb\x87\x34\x75\x1e\xb8\x77\x9f\xd8\xfd\xcb\xaa\x7a\xc9\x2b\xf4\x0c\x01\x0f\x40\x6e\x50\x35\xdd\x94\xf1\xde\x53\x9b\x29\xf6\x00\x06\x3a\x60\x73\x8f\x12\xa6\xad\x94\x4f\xae\x39\xe2\xc0\x29\xf5\x59\xe2\xe8\x41\x0d\x76\x1f\x45\x3f\x5a\xa2\xb4\x27\xae\x4a\x26\xe6\x07\xf0\x8e\x83\x76\xc3\x76\x5c\x56\x55\x4f\x6c\x97\x87\xc7\x40\x96\xc1\x35\xab\x81\x92\x93\x2b\xfe\xf5\x64\xff\x0a\x15\x07\x2f\xb8\x9f\x4b\xd5\xad\x5f\xd1\x2a\x99\x57\xef\x0f\xbe\x23\x83\xcc\x2e\xdf\xec\x8e\xe7\xb2\xe5\x79\xaf\xa9\xf7\x04\x36\x27\xf4\xb0\xe3\x0b\xc2\x28\x72\x1d\xd3\x25\x9e\x4b\x98\xe3\xea\xa6\x6d\xc7\x98\xb4\xa0\x3b\x58\x68\xde\x08\x3c\xcf\xb4\xdd\x28\x0c\xcc\xc8\x0c\xed\xd8\x60\x66\xe8\x11\x53\xb7\x99\x8d\xc9\x0e\x01\xab\x53\x78\x65\x1c\x8e\xa0\xcb\xc1\x93\x05\xa2\xdd\xef\x5c\x89\x56\x90\x9b\x8a\x39\xe2\x9e\x20\xfb\xc4\x72\xf0\xeb\x2a\x95\xb7\xd8\x86\xf5\x97\x2d\xd6\x04\x2f\x3f\xf2\x06\x79\x7b\xb7\x01\x9e\xce\x86\xd9\x27\x93\x3f\x8e\xac\x67\x18\xcd\x46\x56\xa9\x0a\x65\x70\xdd\x6f\xf3\xb4\x5e\x32\x77\x74\x8a\x99\xe6\xbb\x5f\xec\xdc\x2e\x33\x08\xb6\x2a\x2b\x4d\x9e\x41\xc2\x65\x56\x56\xf7\x00\x40\xa8\x0a\x99\x5f\x2f\x65\xda\x76\x67\x05\xdc\x6b\x6d\xf1\xcb\x0b\xfe\xf3\x8b\x0b\x2d\xfd\xf7\x62\x26\x4b\x34\xca\x3a\x39\xb2\x58\x1a\xa7\xd5\x45\x55\xc3\x78\xa7\x45\x35\x82\x2d\x27\xea\x6a\x53\x55\x07\xf9\x30\xbe\x89\xdf\x8e\x7a\x46\x99\xdc\x0c\xae\x79\x22\x4f\x15\xa5\xf3\xdb\x53\x4d\x2f\xe7\xff\x03\x99\x10\x5d\xb8\x5e\x1d\x01\x00")
 
 func thorYamlBytes() ([]byte, error) {
 	return bindataRead(
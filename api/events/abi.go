@@ -0,0 +1,220 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/abi"
+	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/thor"
+)
+
+// ArgValues accepts either a single value or an array of values, in the form of string, number or bool.
+// An event matches if the indexed argument equals to any of the values.
+type ArgValues []string
+
+func (v *ArgValues) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		var raws []json.RawMessage
+		if err := json.Unmarshal(data, &raws); err != nil {
+			return err
+		}
+		values := make(ArgValues, 0, len(raws))
+		for _, raw := range raws {
+			value, err := parseArgValue(raw)
+			if err != nil {
+				return err
+			}
+			values = append(values, value)
+		}
+		*v = values
+		return nil
+	}
+	if string(data) == "null" {
+		*v = nil
+		return nil
+	}
+	value, err := parseArgValue(data)
+	if err != nil {
+		return err
+	}
+	*v = ArgValues{value}
+	return nil
+}
+
+func parseArgValue(data json.RawMessage) (string, error) {
+	var value interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&value); err != nil {
+		return "", err
+	}
+	switch value := value.(type) {
+	case string:
+		return value, nil
+	case json.Number:
+		return value.String(), nil
+	case bool:
+		return fmt.Sprint(value), nil
+	default:
+		return "", errors.New("value should be string, number or bool")
+	}
+}
+
+// DecodedEvent the event decoded by the ABI given in filter.
+type DecodedEvent struct {
+	Event string                 `json:"event"`
+	Args  map[string]interface{} `json:"args"`
+}
+
+// eventDecoder holds the events selected from the ABI given in filter.
+type eventDecoder struct {
+	events []*abi.Event
+}
+
+// newEventDecoder parses the ABI of filter, which can be either a single event fragment or a whole contract ABI.
+// Returns nil if no ABI given.
+func newEventDecoder(filter *EventFilter) (*eventDecoder, error) {
+	data := bytes.TrimSpace(filter.ABI)
+	if len(data) == 0 || string(data) == "null" {
+		if filter.Event != "" || len(filter.Args) > 0 {
+			return nil, utils.BadRequest(errors.New("abi: required by event or args"))
+		}
+		return nil, nil
+	}
+	if data[0] == '{' {
+		data = append(append([]byte{'['}, data...), ']')
+	}
+	contract, err := abi.New(data)
+	if err != nil {
+		return nil, utils.BadRequest(errors.WithMessage(err, "abi"))
+	}
+
+	var events []*abi.Event
+	if filter.Event != "" {
+		event, found := contract.EventByName(filter.Event)
+		if !found {
+			return nil, utils.BadRequest(errors.New("event: not found in abi"))
+		}
+		events = append(events, event)
+	} else {
+		// anonymous events can't be identified by topic0
+		for _, event := range contract.Events() {
+			if !event.Anonymous() {
+				events = append(events, event)
+			}
+		}
+		if len(events) == 0 {
+			return nil, utils.BadRequest(errors.New("abi: no event found"))
+		}
+		if len(filter.Args) > 0 && len(events) > 1 {
+			return nil, utils.BadRequest(errors.New("event: required to filter by args"))
+		}
+	}
+	return &eventDecoder{events}, nil
+}
+
+// topics computes topic constraints of selected events and indexed argument values.
+func (d *eventDecoder) topics(args map[string]ArgValues) ([5][]thor.Bytes32, error) {
+	var topics [5][]thor.Bytes32
+	for _, event := range d.events {
+		if !event.Anonymous() {
+			topics[0] = append(topics[0], event.ID())
+		}
+	}
+	for name, values := range args {
+		if len(values) == 0 {
+			continue
+		}
+		if len(values) > maxSetSize {
+			return topics, utils.BadRequest(fmt.Errorf("args.%s: too many values", name))
+		}
+		set := make([]thor.Bytes32, 0, len(values))
+		index := 0
+		for _, value := range values {
+			i, topic, err := d.events[0].EncodeTopic(name, value)
+			if err != nil {
+				return topics, utils.BadRequest(errors.WithMessage(err, "args."+name))
+			}
+			index = i
+			set = append(set, topic)
+		}
+		if index >= len(topics) {
+			return topics, utils.BadRequest(fmt.Errorf("args.%s: too many indexed arguments", name))
+		}
+		topics[index] = set
+	}
+	return topics, nil
+}
+
+// decode decodes the event if it's one of selected events.
+// Returns nil if not matched or failed to decode.
+func (d *eventDecoder) decode(topics []*thor.Bytes32, data []byte) *DecodedEvent {
+	values := make([]thor.Bytes32, len(topics))
+	for i, topic := range topics {
+		values[i] = *topic
+	}
+	for _, event := range d.events {
+		if !event.Anonymous() && (len(values) == 0 || values[0] != event.ID()) {
+			continue
+		}
+		args, err := event.DecodeArgs(values, data)
+		if err != nil {
+			return nil
+		}
+		for name, arg := range args {
+			args[name] = formatArgValue(reflect.ValueOf(arg))
+		}
+		return &DecodedEvent{event.Name(), args}
+	}
+	return nil
+}
+
+// formatArgValue converts decoded value into json friendly form.
+// Integers are in decimal string, while addresses and bytes are in hex string.
+func formatArgValue(v reflect.Value) interface{} {
+	switch value := v.Interface().(type) {
+	case *big.Int:
+		return value.String()
+	case common.Address:
+		return thor.Address(value).String()
+	case thor.Bytes32:
+		return value.String()
+	case []byte:
+		return hexutil.Encode(value)
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return fmt.Sprint(v.Interface())
+	case reflect.Array:
+		// fixed bytes
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, v.Len())
+			for i := range b {
+				b[i] = byte(v.Index(i).Uint())
+			}
+			return hexutil.Encode(b)
+		}
+		fallthrough
+	case reflect.Slice:
+		values := make([]interface{}, v.Len())
+		for i := range values {
+			values[i] = formatArgValue(v.Index(i))
+		}
+		return values
+	}
+	return v.Interface()
+}
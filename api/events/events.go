@@ -31,10 +31,13 @@ func New(repo *chain.Repository, db *logdb.LogDB) *Events {
 //Filter query events with option
 func (e *Events) filter(ctx context.Context, ef *EventFilter) ([]*FilteredEvent, error) {
 	chain := e.repo.NewBestChain()
-	filter, err := convertEventFilter(chain, ef)
+	filter, decoder, err := convertEventFilter(chain, ef)
 	if err != nil {
 		return nil, err
 	}
+	if filter == nil {
+		return []*FilteredEvent{}, nil
+	}
 	events, err := e.db.FilterEvents(ctx, filter)
 	if err != nil {
 		return nil, err
@@ -42,15 +45,36 @@ func (e *Events) filter(ctx context.Context, ef *EventFilter) ([]*FilteredEvent,
 	fes := make([]*FilteredEvent, len(events))
 	for i, e := range events {
 		fes[i] = convertEvent(e)
+		if decoder != nil {
+			fes[i].Decoded = decoder.decode(fes[i].Topics, e.Data)
+		}
 	}
 	return fes, nil
 }
 
+func (e *Events) count(ctx context.Context, ef *EventFilter) (uint64, error) {
+	filter, _, err := convertEventFilter(e.repo.NewBestChain(), ef)
+	if err != nil {
+		return 0, err
+	}
+	if filter == nil {
+		return 0, nil
+	}
+	return e.db.CountEvents(ctx, filter)
+}
+
 func (e *Events) handleFilter(w http.ResponseWriter, req *http.Request) error {
 	var filter EventFilter
 	if err := utils.ParseJSON(req.Body, &filter); err != nil {
 		return utils.BadRequest(errors.WithMessage(err, "body"))
 	}
+	if req.URL.Query().Get("count") == "true" {
+		count, err := e.count(req.Context(), &filter)
+		if err != nil {
+			return err
+		}
+		return utils.WriteJSON(w, map[string]uint64{"count": count})
+	}
 	fes, err := e.filter(req.Context(), &filter)
 	if err != nil {
 		return err
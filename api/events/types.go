@@ -6,10 +6,13 @@
 package events
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/api/utils"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/logdb"
@@ -23,14 +26,67 @@ type LogMeta struct {
 	TxID           thor.Bytes32 `json:"txID"`
 	TxOrigin       thor.Address `json:"txOrigin"`
 	ClauseIndex    uint32       `json:"clauseIndex"`
+	Cursor         string       `json:"cursor"`
 }
 
+// max count of values in an address or topic set.
+const maxSetSize = 1024
+
+// AddressSet accepts either a single address or an array of addresses.
+type AddressSet []thor.Address
+
+func (s *AddressSet) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '[' {
+		var addrs []thor.Address
+		if err := json.Unmarshal(data, &addrs); err != nil {
+			return err
+		}
+		*s = addrs
+		return nil
+	}
+	var addr *thor.Address
+	if err := json.Unmarshal(data, &addr); err != nil {
+		return err
+	}
+	if addr == nil {
+		*s = nil
+	} else {
+		*s = AddressSet{*addr}
+	}
+	return nil
+}
+
+// Bytes32Set accepts either a single bytes32 or an array of bytes32.
+type Bytes32Set []thor.Bytes32
+
+func (s *Bytes32Set) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '[' {
+		var values []thor.Bytes32
+		if err := json.Unmarshal(data, &values); err != nil {
+			return err
+		}
+		*s = values
+		return nil
+	}
+	var value *thor.Bytes32
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	if value == nil {
+		*s = nil
+	} else {
+		*s = Bytes32Set{*value}
+	}
+	return nil
+}
+
+// TopicSet each topic matches any of the values in its set.
 type TopicSet struct {
-	Topic0 *thor.Bytes32 `json:"topic0"`
-	Topic1 *thor.Bytes32 `json:"topic1"`
-	Topic2 *thor.Bytes32 `json:"topic2"`
-	Topic3 *thor.Bytes32 `json:"topic3"`
-	Topic4 *thor.Bytes32 `json:"topic4"`
+	Topic0 Bytes32Set `json:"topic0"`
+	Topic1 Bytes32Set `json:"topic1"`
+	Topic2 Bytes32Set `json:"topic2"`
+	Topic3 Bytes32Set `json:"topic3"`
+	Topic4 Bytes32Set `json:"topic4"`
 }
 
 // FilteredEvent only comes from one contract
@@ -39,6 +95,7 @@ type FilteredEvent struct {
 	Topics  []*thor.Bytes32 `json:"topics"`
 	Data    string          `json:"data"`
 	Meta    LogMeta         `json:"meta"`
+	Decoded *DecodedEvent   `json:"decoded,omitempty"`
 }
 
 //convert a logdb.Event into a json format Event
@@ -53,6 +110,7 @@ func convertEvent(event *logdb.Event) *FilteredEvent {
 			TxID:           event.TxID,
 			TxOrigin:       event.TxOrigin,
 			ClauseIndex:    event.ClauseIndex,
+			Cursor:         (&logdb.Cursor{BlockNumber: event.BlockNumber, Index: event.Index}).String(),
 		},
 	}
 	fe.Topics = make([]*thor.Bytes32, 0)
@@ -89,46 +147,146 @@ func (e *FilteredEvent) String() string {
 	)
 }
 
+// EventCriteria matches events with any of the addresses, and any of the topics at each position.
 type EventCriteria struct {
-	Address *thor.Address `json:"address"`
+	Address AddressSet `json:"address"`
 	TopicSet
 }
 
+// EventFilter filters events by criteria set.
+// If ABI given, either an event fragment or a whole contract ABI, events are further constrained to the
+// events of ABI (or the one named by Event), and the values of indexed arguments in Args.
+// Matched events are then decoded.
 type EventFilter struct {
-	CriteriaSet []*EventCriteria `json:"criteriaSet"`
-	Range       *Range           `json:"range"`
-	Options     *logdb.Options   `json:"options"`
-	Order       logdb.Order      `json:"order"`
+	CriteriaSet []*EventCriteria     `json:"criteriaSet"`
+	Range       *Range               `json:"range"`
+	Options     *Options             `json:"options"`
+	Order       logdb.Order          `json:"order"`
+	ABI         json.RawMessage      `json:"abi"`
+	Event       string               `json:"event"`
+	Args        map[string]ArgValues `json:"args"`
 }
 
-func convertEventFilter(chain *chain.Chain, filter *EventFilter) (*logdb.EventFilter, error) {
+// convertEventFilter converts the filter into logdb filter and the decoder of the given event ABI.
+// The returned logdb filter is nil if no event can match the filter.
+func convertEventFilter(chain *chain.Chain, filter *EventFilter) (*logdb.EventFilter, *eventDecoder, error) {
 	rng, err := ConvertRange(chain, filter.Range)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	opts, err := ConvertOptions(filter.Options)
+	if err != nil {
+		return nil, nil, err
+	}
+	decoder, err := newEventDecoder(filter)
+	if err != nil {
+		return nil, nil, err
 	}
 	f := &logdb.EventFilter{
 		Range:   rng,
-		Options: filter.Options,
+		Options: opts,
 		Order:   filter.Order,
 	}
 	if len(filter.CriteriaSet) > 0 {
 		criterias := make([]*logdb.EventCriteria, len(filter.CriteriaSet))
 		for i, criteria := range filter.CriteriaSet {
-			var topics [5]*thor.Bytes32
-			topics[0] = criteria.Topic0
-			topics[1] = criteria.Topic1
-			topics[2] = criteria.Topic2
-			topics[3] = criteria.Topic3
-			topics[4] = criteria.Topic4
-			criteria := &logdb.EventCriteria{
+			topics := [5][]thor.Bytes32{
+				criteria.Topic0,
+				criteria.Topic1,
+				criteria.Topic2,
+				criteria.Topic3,
+				criteria.Topic4,
+			}
+			if len(criteria.Address) > maxSetSize {
+				return nil, nil, utils.BadRequest(fmt.Errorf("criteriaSet[%d].address: too many values", i))
+			}
+			for j, topic := range topics {
+				if len(topic) > maxSetSize {
+					return nil, nil, utils.BadRequest(fmt.Errorf("criteriaSet[%d].topic%d: too many values", i, j))
+				}
+			}
+			criterias[i] = &logdb.EventCriteria{
 				Address: criteria.Address,
 				Topics:  topics,
 			}
-			criterias[i] = criteria
 		}
 		f.CriteriaSet = criterias
 	}
-	return f, nil
+	if decoder != nil {
+		topics, err := decoder.topics(filter.Args)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(f.CriteriaSet) == 0 {
+			f.CriteriaSet = []*logdb.EventCriteria{{}}
+		}
+		var criterias []*logdb.EventCriteria
+		for _, criteria := range f.CriteriaSet {
+			if mergeTopics(criteria, topics) {
+				criterias = append(criterias, criteria)
+			}
+		}
+		if len(criterias) == 0 {
+			// no criteria can be satisfied
+			return nil, decoder, nil
+		}
+		f.CriteriaSet = criterias
+	}
+	return f, decoder, nil
+}
+
+// mergeTopics constrains the topics of criteria with the given topics.
+// Returns false if the criteria can never be satisfied.
+func mergeTopics(criteria *logdb.EventCriteria, topics [5][]thor.Bytes32) bool {
+	for i, set := range topics {
+		if len(set) == 0 {
+			continue
+		}
+		if len(criteria.Topics[i]) == 0 {
+			criteria.Topics[i] = set
+			continue
+		}
+		var merged []thor.Bytes32
+		for _, a := range criteria.Topics[i] {
+			for _, b := range set {
+				if a == b {
+					merged = append(merged, a)
+					break
+				}
+			}
+		}
+		if len(merged) == 0 {
+			return false
+		}
+		criteria.Topics[i] = merged
+	}
+	return true
+}
+
+// Options paging options.
+// Cursor is taken from the meta of the last returned log, to query the next page after it.
+type Options struct {
+	Offset uint64 `json:"offset"`
+	Limit  uint64 `json:"limit"`
+	Cursor string `json:"cursor"`
+}
+
+func ConvertOptions(o *Options) (*logdb.Options, error) {
+	if o == nil {
+		return nil, nil
+	}
+	opts := &logdb.Options{
+		Offset: o.Offset,
+		Limit:  o.Limit,
+	}
+	if o.Cursor != "" {
+		cursor, err := logdb.ParseCursor(o.Cursor)
+		if err != nil {
+			return nil, utils.BadRequest(errors.WithMessage(err, "cursor"))
+		}
+		opts.Cursor = cursor
+	}
+	return opts, nil
 }
 
 type RangeType string
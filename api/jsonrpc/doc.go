@@ -0,0 +1,45 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package jsonrpc implements an ethereum compatible JSON-RPC gateway on top of the REST API.
+//
+// Requests are served by POST over http, or over websocket by GET (upgrade) on the same path.
+// Batch requests are supported. The following methods are available:
+//
+//	eth_chainId, eth_blockNumber, eth_getBlockByNumber, eth_getBlockByHash,
+//	eth_getTransactionByHash, eth_getTransactionReceipt, eth_call, eth_estimateGas,
+//	eth_getLogs, eth_sendRawTransaction, eth_subscribe, eth_unsubscribe
+//
+// eth_subscribe and eth_unsubscribe are only available over websocket, with
+// 'newHeads' and 'logs' subscriptions supported.
+//
+// Semantic differences from ethereum:
+//
+// A thor tx carries multiple clauses. The 'to', 'value' and 'input' fields of a tx object
+// are taken from the first clause, and all clauses are listed in the extra 'clauses' field.
+// Likewise, the 'to' field of a receipt is the first clause's recipient, and 'contractAddress'
+// is the address of the first contract created by the tx.
+//
+// Gas is paid in VTHO (energy) rather than the native token. 'gasPrice' of a tx is derived from
+// the base gas price and the tx's 'gasPriceCoef', and the extra receipt fields 'gasPayer',
+// 'paid' and 'reward' are denominated in VTHO.
+//
+// The chain id is the chain tag, i.e. the last byte of the genesis block id.
+// Blocks have no uncles, and 'difficulty' is always zero, while 'totalDifficulty'
+// is the total score of the block. 'miner' is the beneficiary of the block.
+// 'logsBloom' is always zero, logs should be queried by eth_getLogs instead.
+//
+// Thor has no pending block, the 'pending' tag is treated as 'latest'.
+//
+// eth_call executes a single clause, and eth_estimateGas returns the sum of the intrinsic
+// gas and the gas used by the clause. For eth_call, 'from' is the tx origin,
+// and the gas payer is always the zero address.
+//
+// eth_sendRawTransaction accepts RLP encoded thor txs only. Ethereum signed txs are rejected.
+// If the tx is rejected by txpool policy, the error 'data' is the reason code.
+//
+// eth_getLogs only queries the best chain, and fails if more than 10000 logs matched.
+// Up to 5 topics can be filtered.
+package jsonrpc
@@ -0,0 +1,242 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package jsonrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"github.com/inconshreveable/log15"
+	"github.com/vechain/thor/api/accounts"
+	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/logdb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/txpool"
+)
+
+var log = log15.New("pkg", "jsonrpc")
+
+const (
+	// max size of a http request body.
+	maxRequestSize = 5 * 1024 * 1024
+	// max count of requests in a batch.
+	maxBatchSize = 100
+
+	// Time allowed to read the next pong message from the peer.
+	pongWait = 60 * time.Second
+	// Send pings to peer with this period. Must be less than pongWait.
+	pingPeriod = (pongWait * 7) / 10
+	// Time allowed to write a message to the peer.
+	writeWait = 10 * time.Second
+)
+
+// JSONRPC serves a subset of the ethereum JSON-RPC API over http and websocket.
+type JSONRPC struct {
+	repo     *chain.Repository
+	stater   *state.Stater
+	accounts *accounts.Accounts
+	txPool   *txpool.TxPool
+	logDB    *logdb.LogDB
+	upgrader *websocket.Upgrader
+	done     chan struct{}
+	wg       sync.WaitGroup
+}
+
+// New creates a JSONRPC instance.
+// logDB can be nil, which disables eth_getLogs. Calls are served by the given accounts API, so that both share
+// the same limits.
+func New(
+	repo *chain.Repository,
+	stater *state.Stater,
+	txPool *txpool.TxPool,
+	logDB *logdb.LogDB,
+	accounts *accounts.Accounts,
+	allowedOrigins []string,
+) *JSONRPC {
+	return &JSONRPC{
+		repo:     repo,
+		stater:   stater,
+		accounts: accounts,
+		txPool:   txPool,
+		logDB:    logDB,
+		upgrader: &websocket.Upgrader{
+			EnableCompression: true,
+			CheckOrigin: func(r *http.Request) bool {
+				origin := r.Header.Get("Origin")
+				if origin == "" {
+					return true
+				}
+				for _, allowedOrigin := range allowedOrigins {
+					if allowedOrigin == origin || allowedOrigin == "*" {
+						return true
+					}
+				}
+				return false
+			},
+		},
+		done: make(chan struct{}),
+	}
+}
+
+func (j *JSONRPC) handleHTTP(w http.ResponseWriter, req *http.Request) error {
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, req.Body, maxRequestSize))
+	if err != nil {
+		return utils.HTTPError(err, http.StatusRequestEntityTooLarge)
+	}
+	resp := handleMessage(req.Context(), body, j.call)
+	if resp == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+	return utils.WriteJSON(w, resp)
+}
+
+// callFunc executes the method of the request.
+type callFunc func(ctx context.Context, req *request) (interface{}, error)
+
+// handleMessage handles a single request or a batch of requests.
+// It returns nil if nothing to respond.
+func handleMessage(ctx context.Context, msg []byte, call callFunc) interface{} {
+	msg = bytes.TrimSpace(msg)
+	if len(msg) > 0 && msg[0] == '[' {
+		var batch []json.RawMessage
+		if err := json.Unmarshal(msg, &batch); err != nil {
+			return errorResponse(nil, &rpcError{Code: codeParseError, Message: err.Error()})
+		}
+		if len(batch) == 0 {
+			return errorResponse(nil, &rpcError{Code: codeInvalidRequest, Message: "empty batch"})
+		}
+		if len(batch) > maxBatchSize {
+			return errorResponse(nil, &rpcError{Code: codeInvalidRequest, Message: "batch too large"})
+		}
+		resps := make([]*response, 0, len(batch))
+		for _, raw := range batch {
+			if resp := handleSingle(ctx, raw, call); resp != nil {
+				resps = append(resps, resp)
+			}
+		}
+		if len(resps) == 0 {
+			return nil
+		}
+		return resps
+	}
+	if resp := handleSingle(ctx, msg, call); resp != nil {
+		return resp
+	}
+	return nil
+}
+
+func handleSingle(ctx context.Context, raw json.RawMessage, call callFunc) *response {
+	var req request
+	if err := json.Unmarshal(raw, &req); err != nil {
+		return errorResponse(nil, &rpcError{Code: codeParseError, Message: err.Error()})
+	}
+	if req.Version != version || req.Method == "" {
+		return errorResponse(req.ID, &rpcError{Code: codeInvalidRequest, Message: "invalid request"})
+	}
+	result, err := call(ctx, &req)
+	if req.isNotification() {
+		return nil
+	}
+	return newResponse(req.ID, result, err)
+}
+
+// call dispatches the request to the method handler.
+func (j *JSONRPC) call(ctx context.Context, req *request) (interface{}, error) {
+	switch req.Method {
+	case "eth_chainId":
+		return j.chainID()
+	case "eth_blockNumber":
+		return j.blockNumber()
+	case "eth_getBlockByNumber":
+		return j.getBlockByNumber(req.Params)
+	case "eth_getBlockByHash":
+		return j.getBlockByHash(req.Params)
+	case "eth_getTransactionByHash":
+		return j.getTransactionByHash(req.Params)
+	case "eth_getTransactionReceipt":
+		return j.getTransactionReceipt(req.Params)
+	case "eth_call":
+		return j.ethCall(ctx, req.Params)
+	case "eth_estimateGas":
+		return j.estimateGas(ctx, req.Params)
+	case "eth_getLogs":
+		return j.getLogs(ctx, req.Params)
+	case "eth_sendRawTransaction":
+		return j.sendRawTransaction(req.Params)
+	case "eth_subscribe", "eth_unsubscribe":
+		return nil, &rpcError{Code: codeMethodNotFound, Message: "notifications not supported, use websocket instead"}
+	default:
+		return nil, &rpcError{Code: codeMethodNotFound, Message: "the method " + req.Method + " does not exist"}
+	}
+}
+
+func newResponse(id json.RawMessage, result interface{}, err error) *response {
+	if err != nil {
+		rpcErr, ok := err.(*rpcError)
+		if !ok {
+			rpcErr = &rpcError{Code: codeServerError, Message: err.Error()}
+		}
+		return errorResponse(id, rpcErr)
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		return errorResponse(id, &rpcError{Code: codeServerError, Message: err.Error()})
+	}
+	return &response{Version: version, ID: normalizeID(id), Result: data}
+}
+
+func errorResponse(id json.RawMessage, err *rpcError) *response {
+	return &response{Version: version, ID: normalizeID(id), Error: err}
+}
+
+func normalizeID(id json.RawMessage) json.RawMessage {
+	if len(id) == 0 {
+		return json.RawMessage("null")
+	}
+	return id
+}
+
+func (j *JSONRPC) handleWebsocket(w http.ResponseWriter, req *http.Request) error {
+	j.wg.Add(1)
+	defer j.wg.Done()
+
+	conn, err := j.upgrader.Upgrade(w, req, nil)
+	// since the conn is hijacked here, no error should be returned in lines below
+	if err != nil {
+		log.Debug("upgrade to websocket", "err", err)
+		return nil
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Debug("close websocket", "err", err)
+		}
+	}()
+
+	newWSConn(j, conn).serve()
+	return nil
+}
+
+// Close terminates all websocket connections.
+func (j *JSONRPC) Close() {
+	close(j.done)
+	j.wg.Wait()
+}
+
+func (j *JSONRPC) Mount(root *mux.Router, pathPrefix string) {
+	sub := root.PathPrefix(pathPrefix).Subrouter()
+
+	sub.Path("").Methods("POST").HandlerFunc(utils.WrapHandlerFunc(j.handleHTTP))
+	sub.Path("").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(j.handleWebsocket))
+}
@@ -0,0 +1,258 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package jsonrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/api/accounts"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/finality"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/logdb"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/packer"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/txpool"
+)
+
+var (
+	ts    *httptest.Server
+	blk   *block.Block
+	trx   *tx.Transaction
+	chTag byte
+)
+
+func TestJSONRPC(t *testing.T) {
+	initServer(t)
+	defer ts.Close()
+
+	var num hexutil.Uint64
+	callRPC(t, "eth_blockNumber", nil, &num)
+	assert.Equal(t, hexutil.Uint64(1), num)
+
+	var chainID hexutil.Uint64
+	callRPC(t, "eth_chainId", nil, &chainID)
+	assert.Equal(t, hexutil.Uint64(chTag), chainID)
+
+	var b struct {
+		Number       hexutil.Uint64 `json:"number"`
+		Hash         thor.Bytes32   `json:"hash"`
+		ParentHash   thor.Bytes32   `json:"parentHash"`
+		Transactions []thor.Bytes32 `json:"transactions"`
+	}
+	callRPC(t, "eth_getBlockByNumber", []interface{}{"latest", false}, &b)
+	assert.Equal(t, hexutil.Uint64(1), b.Number)
+	assert.Equal(t, blk.Header().ID(), b.Hash)
+	assert.Equal(t, blk.Header().ParentID(), b.ParentHash)
+	assert.Equal(t, []thor.Bytes32{trx.ID()}, b.Transactions)
+
+	var fullBlock struct {
+		Transactions []*Transaction `json:"transactions"`
+	}
+	callRPC(t, "eth_getBlockByHash", []interface{}{blk.Header().ID().String(), true}, &fullBlock)
+	assert.Equal(t, 1, len(fullBlock.Transactions))
+	assert.Equal(t, trx.ID(), fullBlock.Transactions[0].Hash)
+	assert.Equal(t, *trx.Clauses()[0].To(), *fullBlock.Transactions[0].To)
+	assert.Equal(t, trx.Clauses()[0].Value(), fullBlock.Transactions[0].Value.ToInt())
+
+	var receipt Receipt
+	callRPC(t, "eth_getTransactionReceipt", []interface{}{trx.ID().String()}, &receipt)
+	assert.Equal(t, trx.ID(), receipt.TransactionHash)
+	assert.Equal(t, blk.Header().ID(), receipt.BlockHash)
+	assert.Equal(t, hexutil.Uint64(1), receipt.Status)
+	assert.Equal(t, genesis.DevAccounts()[0].Address, receipt.From)
+
+	var missing *Receipt
+	callRPC(t, "eth_getTransactionReceipt", []interface{}{thor.Bytes32{}.String()}, &missing)
+	assert.Nil(t, missing)
+
+	var logs []*Log
+	callRPC(t, "eth_getLogs", []interface{}{map[string]interface{}{"fromBlock": "earliest"}}, &logs)
+	assert.Equal(t, 0, len(logs))
+
+	var gas hexutil.Uint64
+	callRPC(t, "eth_estimateGas", []interface{}{map[string]interface{}{
+		"from":  genesis.DevAccounts()[0].Address.String(),
+		"to":    thor.BytesToAddress([]byte("to")).String(),
+		"value": "0x1",
+	}}, &gas)
+	assert.Equal(t, hexutil.Uint64(21000), gas)
+
+	resp := post(t, []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_foo"}`))
+	assert.Equal(t, codeMethodNotFound, resp.Error.Code)
+
+	resp = post(t, []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["0xzz"]}`))
+	assert.Equal(t, codeInvalidParams, resp.Error.Code)
+
+	resp = post(t, []byte(`{bad json`))
+	assert.Equal(t, codeParseError, resp.Error.Code)
+
+	res, err := http.Post(ts.URL+"/jsonrpc", "application/json", bytes.NewReader([]byte(
+		`[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},{"jsonrpc":"2.0","method":"eth_blockNumber"},{"jsonrpc":"2.0","id":2,"method":"eth_chainId"}]`)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var batch []*response
+	if err := json.NewDecoder(res.Body).Decode(&batch); err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	assert.Equal(t, 2, len(batch), "notification should not be responded")
+	assert.Equal(t, "1", string(batch[0].ID))
+	assert.Equal(t, "2", string(batch[1].ID))
+}
+
+func TestBuildCriteria(t *testing.T) {
+	addr1 := thor.BytesToAddress([]byte("addr1"))
+	addr2 := thor.BytesToAddress([]byte("addr2"))
+	topic1 := thor.BytesToBytes32([]byte("topic1"))
+	topic2 := thor.BytesToBytes32([]byte("topic2"))
+
+	criteria, err := buildCriteria(&FilterQuery{
+		Address: addressList{addr1, addr2},
+		Topics:  []topicList{nil, {topic1, topic2}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, &logdb.EventCriteria{
+		Address: []thor.Address{addr1, addr2},
+		Topics:  [5][]thor.Bytes32{nil, {topic1, topic2}},
+	}, criteria)
+
+	many := make(topicList, maxAlternatives+1)
+	_, err = buildCriteria(&FilterQuery{Topics: []topicList{nil, many}})
+	assert.NotNil(t, err)
+
+	_, err = buildCriteria(&FilterQuery{Topics: make([]topicList, 6)})
+	assert.NotNil(t, err)
+
+	event := &tx.Event{Address: addr1, Topics: []thor.Bytes32{topic2}}
+	assert.True(t, (&FilterQuery{Address: addressList{addr2, addr1}}).Match(event))
+	assert.True(t, (&FilterQuery{Topics: []topicList{{topic1, topic2}}}).Match(event))
+	assert.False(t, (&FilterQuery{Topics: []topicList{{topic1}}}).Match(event))
+	assert.False(t, (&FilterQuery{Topics: []topicList{nil, {topic1}}}).Match(event))
+}
+
+func initServer(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	gene := genesis.NewDevnet()
+
+	b, _, _, err := gene.Build(stater)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, _ := chain.NewRepository(db, b)
+	addr := thor.BytesToAddress([]byte("to"))
+	cla := tx.NewClause(&addr).WithValue(big.NewInt(10000))
+	trx = new(tx.Builder).
+		ChainTag(repo.ChainTag()).
+		GasPriceCoef(1).
+		Expiration(10).
+		Gas(21000).
+		Nonce(1).
+		Clause(cla).
+		BlockRef(tx.NewBlockRef(0)).
+		Build()
+
+	sig, err := crypto.Sign(trx.SigningHash().Bytes(), genesis.DevAccounts()[0].PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	trx = trx.WithSignature(sig)
+	packer := packer.New(repo, stater, genesis.DevAccounts()[0].Address, &genesis.DevAccounts()[0].Address, thor.NoFork)
+	flow, err := packer.Schedule(b.Header(), uint64(time.Now().Unix()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := flow.Adopt(trx); err != nil {
+		t.Fatal(err)
+	}
+	packed, stage, receipts, err := flow.Pack(genesis.DevAccounts()[0].PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blk = packed
+	if _, err := stage.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.AddBlock(blk, receipts); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.SetBestBlockID(blk.Header().ID()); err != nil {
+		t.Fatal(err)
+	}
+	logDB, err := logdb.NewMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := logDB.Log(func(w *logdb.Writer) error {
+		return w.Write(blk, receipts)
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	pool := txpool.New(repo, stater, txpool.Options{Limit: 10000, LimitPerAccount: 16, MaxLifetime: 10 * time.Minute})
+	router := mux.NewRouter()
+	accs := accounts.New(repo, stater, finality.New(repo, stater), nil, 10000000, 1000, thor.NoFork)
+	New(repo, stater, pool, logDB, accs, []string{"*"}).Mount(router, "/jsonrpc")
+	ts = httptest.NewServer(router)
+	chTag = repo.ChainTag()
+}
+
+func post(t *testing.T, body []byte) *response {
+	res, err := http.Post(ts.URL+"/jsonrpc", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	var resp response
+	if err := json.Unmarshal(data, &resp); err != nil {
+		t.Fatal(err)
+	}
+	return &resp
+}
+
+func callRPC(t *testing.T, method string, params []interface{}, result interface{}) {
+	req := map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  method,
+	}
+	if params != nil {
+		req["params"] = params
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp := post(t, body)
+	if resp.Error != nil {
+		t.Fatal(method, resp.Error.Message)
+	}
+	if err := json.Unmarshal(resp.Result, result); err != nil {
+		t.Fatal(err)
+	}
+}
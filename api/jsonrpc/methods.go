@@ -0,0 +1,413 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethmath "github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/api/accounts"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/logdb"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/txpool"
+)
+
+const (
+	// max count of logs returned by eth_getLogs.
+	maxLogs = 10000
+	// max count of addresses, or alternatives of a topic of a log filter.
+	maxAlternatives = 1024
+
+	// the vm error message of REVERT opcode.
+	vmErrExecutionReverted = "evm: execution reverted"
+)
+
+var errLogsDisabled = errors.New("logs are disabled on this node")
+
+func (j *JSONRPC) chainID() (interface{}, error) {
+	return hexutil.Uint64(j.repo.ChainTag()), nil
+}
+
+func (j *JSONRPC) blockNumber() (interface{}, error) {
+	return hexutil.Uint64(j.repo.BestBlock().Header().Number()), nil
+}
+
+// parseBlockNumber resolves block tag or hex encoded number.
+// 'pending' is treated as 'latest', since there is no pending block in thor.
+func (j *JSONRPC) parseBlockNumber(tag string) (uint32, error) {
+	switch tag {
+	case "", "latest", "pending":
+		return j.repo.BestBlock().Header().Number(), nil
+	case "earliest":
+		return 0, nil
+	}
+	n, err := hexutil.DecodeUint64(tag)
+	if err != nil {
+		return 0, invalidParams(errors.WithMessage(err, "block number"))
+	}
+	if n > math.MaxUint32 {
+		return math.MaxUint32, nil
+	}
+	return uint32(n), nil
+}
+
+// headerByTag returns the header on the best chain of the given tag.
+// nil is returned if no such block.
+func (j *JSONRPC) headerByTag(tag string) (*block.Header, error) {
+	num, err := j.parseBlockNumber(tag)
+	if err != nil {
+		return nil, err
+	}
+	header, err := j.repo.NewBestChain().GetBlockHeader(num)
+	if err != nil {
+		if j.repo.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return header, nil
+}
+
+func (j *JSONRPC) baseGasPrice(header *block.Header) (*big.Int, error) {
+	return builtin.Params.Native(j.stater.NewState(header.StateRoot())).Get(thor.KeyBaseGasPrice)
+}
+
+func (j *JSONRPC) convertBlock(b *block.Block, fullTx bool) (*Block, error) {
+	txs := b.Transactions()
+	ethBlock := &Block{
+		Header:       convertHeader(b),
+		Transactions: make([]interface{}, len(txs)),
+		Uncles:       []thor.Bytes32{},
+	}
+	if !fullTx {
+		for i, t := range txs {
+			id := t.ID()
+			ethBlock.Transactions[i] = &id
+		}
+		return ethBlock, nil
+	}
+
+	baseGasPrice, err := j.baseGasPrice(b.Header())
+	if err != nil {
+		return nil, err
+	}
+	for i, t := range txs {
+		ethBlock.Transactions[i] = convertTransaction(t, b.Header(), uint64(i), baseGasPrice)
+	}
+	return ethBlock, nil
+}
+
+func (j *JSONRPC) getBlockByNumber(params json.RawMessage) (interface{}, error) {
+	var (
+		tag    string
+		fullTx bool
+	)
+	if err := parseParams(params, &tag, &fullTx); err != nil {
+		return nil, err
+	}
+	header, err := j.headerByTag(tag)
+	if err != nil || header == nil {
+		return nil, err
+	}
+	b, err := j.repo.GetBlock(header.ID())
+	if err != nil {
+		return nil, err
+	}
+	return j.convertBlock(b, fullTx)
+}
+
+func (j *JSONRPC) getBlockByHash(params json.RawMessage) (interface{}, error) {
+	var (
+		id     thor.Bytes32
+		fullTx bool
+	)
+	if err := parseParams(params, &id, &fullTx); err != nil {
+		return nil, err
+	}
+	b, err := j.repo.GetBlock(id)
+	if err != nil {
+		if j.repo.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return j.convertBlock(b, fullTx)
+}
+
+func (j *JSONRPC) getTransactionByHash(params json.RawMessage) (interface{}, error) {
+	var id thor.Bytes32
+	if err := parseParams(params, &id); err != nil {
+		return nil, err
+	}
+	t, meta, err := j.repo.NewBestChain().GetTransaction(id)
+	if err != nil {
+		if !j.repo.IsNotFound(err) {
+			return nil, err
+		}
+		pending := j.txPool.Get(id)
+		if pending == nil {
+			return nil, nil
+		}
+		baseGasPrice, err := j.baseGasPrice(j.repo.BestBlock().Header())
+		if err != nil {
+			return nil, err
+		}
+		return convertTransaction(pending, nil, 0, baseGasPrice), nil
+	}
+
+	summary, err := j.repo.GetBlockSummary(meta.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	baseGasPrice, err := j.baseGasPrice(summary.Header)
+	if err != nil {
+		return nil, err
+	}
+	return convertTransaction(t, summary.Header, meta.Index, baseGasPrice), nil
+}
+
+func (j *JSONRPC) getTransactionReceipt(params json.RawMessage) (interface{}, error) {
+	var id thor.Bytes32
+	if err := parseParams(params, &id); err != nil {
+		return nil, err
+	}
+	meta, err := j.repo.NewBestChain().GetTransactionMeta(id)
+	if err != nil {
+		if j.repo.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	b, err := j.repo.GetBlock(meta.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := j.repo.GetBlockReceipts(meta.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	return convertReceipt(b, receipts, int(meta.Index)), nil
+}
+
+// doCall executes the call on the state of the given block.
+// The call is converted into a single-clause batch call.
+func (j *JSONRPC) doCall(ctx context.Context, args *CallArgs, header *block.Header) (*accounts.CallResult, error) {
+	data := &accounts.BatchCallData{
+		Clauses: accounts.Clauses{{
+			To:    args.To,
+			Value: (*ethmath.HexOrDecimal256)(args.Value),
+			Data:  hexutil.Encode(args.data()),
+		}},
+		GasPrice: (*ethmath.HexOrDecimal256)(args.GasPrice),
+		Caller:   args.From,
+	}
+	if args.Gas != nil {
+		data.Gas = uint64(*args.Gas)
+	}
+	results, err := j.accounts.BatchCall(ctx, data, header)
+	if err != nil {
+		return nil, err
+	}
+	result := results[0]
+	if result.Reverted {
+		if result.VMError == vmErrExecutionReverted {
+			msg := "execution reverted"
+			if result.RevertReason != "" {
+				msg += ": " + result.RevertReason
+			}
+			return nil, &rpcError{Code: codeExecutionReverted, Message: msg, Data: result.Data}
+		}
+		return nil, errors.New(result.VMError)
+	}
+	return result, nil
+}
+
+func (j *JSONRPC) parseCall(params json.RawMessage) (*CallArgs, *block.Header, error) {
+	var (
+		args CallArgs
+		tag  string
+	)
+	if err := parseParams(params, &args, &tag); err != nil {
+		return nil, nil, err
+	}
+	header, err := j.headerByTag(tag)
+	if err != nil {
+		return nil, nil, err
+	}
+	if header == nil {
+		return nil, nil, errors.New("header not found")
+	}
+	return &args, header, nil
+}
+
+func (j *JSONRPC) ethCall(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	args, header, err := j.parseCall(params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := j.doCall(ctx, args, header)
+	if err != nil {
+		return nil, err
+	}
+	return result.Data, nil
+}
+
+// estimateGas returns the intrinsic gas of the clause plus the gas used by the call.
+func (j *JSONRPC) estimateGas(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	args, header, err := j.parseCall(params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := j.doCall(ctx, args, header)
+	if err != nil {
+		return nil, err
+	}
+
+	value := new(big.Int)
+	if args.Value != nil {
+		value = (*big.Int)(args.Value)
+	}
+	intrinsicGas, err := tx.IntrinsicGas(tx.NewClause(args.To).WithValue(value).WithData(args.data()))
+	if err != nil {
+		return nil, err
+	}
+	return hexutil.Uint64(intrinsicGas + result.GasUsed), nil
+}
+
+// buildCriteria converts the address and topic alternatives of the filter into logdb criteria.
+func buildCriteria(q *FilterQuery) (*logdb.EventCriteria, error) {
+	if len(q.Topics) > 5 {
+		return nil, invalidParams(errors.New("topics: too many topics"))
+	}
+	if len(q.Address) > maxAlternatives {
+		return nil, invalidParams(errors.New("address: too many addresses"))
+	}
+	criteria := &logdb.EventCriteria{Address: q.Address}
+	for i, alternatives := range q.Topics {
+		if len(alternatives) > maxAlternatives {
+			return nil, invalidParams(errors.New("topics: too many alternatives"))
+		}
+		criteria.Topics[i] = alternatives
+	}
+	return criteria, nil
+}
+
+func (j *JSONRPC) getLogs(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	if j.logDB == nil {
+		return nil, errLogsDisabled
+	}
+	var q FilterQuery
+	if err := parseParams(params, &q); err != nil {
+		return nil, err
+	}
+
+	bestChain := j.repo.NewBestChain()
+	var rng logdb.Range
+	if q.BlockHash != nil {
+		if q.FromBlock != "" || q.ToBlock != "" {
+			return nil, invalidParams(errors.New("blockHash: cannot be used with fromBlock or toBlock"))
+		}
+		has, err := bestChain.HasBlock(*q.BlockHash)
+		if err != nil {
+			return nil, err
+		}
+		if !has {
+			return nil, errors.New("blockHash: block not found in best chain")
+		}
+		rng.From = block.Number(*q.BlockHash)
+		rng.To = rng.From
+	} else {
+		var err error
+		if rng.From, err = j.parseBlockNumber(q.FromBlock); err != nil {
+			return nil, err
+		}
+		if rng.To, err = j.parseBlockNumber(q.ToBlock); err != nil {
+			return nil, err
+		}
+		if rng.From > rng.To {
+			return []*Log{}, nil
+		}
+	}
+
+	criteria, err := buildCriteria(&q)
+	if err != nil {
+		return nil, err
+	}
+	events, err := j.logDB.FilterEvents(ctx, &logdb.EventFilter{
+		CriteriaSet: []*logdb.EventCriteria{criteria},
+		Range:       &rng,
+		Options:     &logdb.Options{Limit: maxLogs + 1},
+		Order:       logdb.ASC,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(events) > maxLogs {
+		return nil, errors.Errorf("query returns more than %d results", maxLogs)
+	}
+
+	txIndices := make(map[thor.Bytes32]uint64)
+	logs := make([]*Log, 0, len(events))
+	for _, ev := range events {
+		txIndex, ok := txIndices[ev.TxID]
+		if !ok && ev.BlockNumber > 0 {
+			meta, err := bestChain.GetTransactionMeta(ev.TxID)
+			if err != nil {
+				return nil, err
+			}
+			txIndex = meta.Index
+			txIndices[ev.TxID] = txIndex
+		}
+		l := &Log{
+			Address:          ev.Address,
+			Topics:           []thor.Bytes32{},
+			Data:             ev.Data,
+			BlockNumber:      hexutil.Uint64(ev.BlockNumber),
+			BlockHash:        ev.BlockID,
+			TransactionHash:  ev.TxID,
+			TransactionIndex: hexutil.Uint64(txIndex),
+			LogIndex:         hexutil.Uint64(ev.Index),
+		}
+		for _, topic := range ev.Topics {
+			if topic != nil {
+				l.Topics = append(l.Topics, *topic)
+			}
+		}
+		logs = append(logs, l)
+	}
+	return logs, nil
+}
+
+func (j *JSONRPC) sendRawTransaction(params json.RawMessage) (interface{}, error) {
+	var raw hexutil.Bytes
+	if err := parseParams(params, &raw); err != nil {
+		return nil, err
+	}
+	if len(raw) == 0 {
+		return nil, invalidParams(errors.New("raw: empty"))
+	}
+	var t *tx.Transaction
+	if err := rlp.DecodeBytes(raw, &t); err != nil {
+		return nil, invalidParams(errors.WithMessage(err, "raw: should be thor encoded tx"))
+	}
+	if err := j.txPool.AddLocal(t); err != nil {
+		if code := txpool.RejectCode(err); code != "" {
+			return nil, &rpcError{Code: codeServerError, Message: err.Error(), Data: code}
+		}
+		return nil, err
+	}
+	id := t.ID()
+	return &id, nil
+}
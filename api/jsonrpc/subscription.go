@@ -0,0 +1,313 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package jsonrpc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/chain"
+)
+
+// max count of active subscriptions per connection.
+const maxSubscriptions = 64
+
+type msgReader interface {
+	Read() (msgs []interface{}, hasMore bool, err error)
+}
+
+// headsReader reads headers of new blocks. Obsolete blocks are skipped.
+type headsReader struct {
+	blockReader chain.BlockReader
+}
+
+func (r *headsReader) Read() ([]interface{}, bool, error) {
+	blocks, err := r.blockReader.Read()
+	if err != nil {
+		return nil, false, err
+	}
+	var msgs []interface{}
+	for _, b := range blocks {
+		if !b.Obsolete {
+			msgs = append(msgs, convertHeader(b.Block))
+		}
+	}
+	return msgs, len(blocks) > 0, nil
+}
+
+// logsReader reads logs of new blocks matching the filter.
+// Logs of obsolete blocks are marked as removed.
+type logsReader struct {
+	repo        *chain.Repository
+	filter      *FilterQuery
+	blockReader chain.BlockReader
+}
+
+func (r *logsReader) Read() ([]interface{}, bool, error) {
+	blocks, err := r.blockReader.Read()
+	if err != nil {
+		return nil, false, err
+	}
+	var msgs []interface{}
+	for _, b := range blocks {
+		receipts, err := r.repo.GetBlockReceipts(b.Header().ID())
+		if err != nil {
+			return nil, false, err
+		}
+		txs := b.Transactions()
+		var logIndex uint64
+		for i, receipt := range receipts {
+			for _, output := range receipt.Outputs {
+				for _, event := range output.Events {
+					if r.filter.Match(event) {
+						msgs = append(msgs, convertLog(b.Header(), txs[i].ID(), uint64(i), logIndex, event, b.Obsolete))
+					}
+					logIndex++
+				}
+			}
+		}
+	}
+	return msgs, len(blocks) > 0, nil
+}
+
+// wsConn serves JSON-RPC over a websocket connection, with eth_subscribe supported.
+type wsConn struct {
+	rpc       *JSONRPC
+	conn      *websocket.Conn
+	writeLock sync.Mutex
+	lock      sync.Mutex
+	subs      map[string]chan struct{}
+	pending   []func() // pipes to be started after the response of subscribe written
+	closed    chan struct{}
+	wg        sync.WaitGroup
+}
+
+func newWSConn(rpc *JSONRPC, conn *websocket.Conn) *wsConn {
+	return &wsConn{
+		rpc:    rpc,
+		conn:   conn,
+		subs:   make(map[string]chan struct{}),
+		closed: make(chan struct{}),
+	}
+}
+
+// write writes a message to the conn, with the write deadline set.
+func (c *wsConn) write(v interface{}) error {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+	if err := c.conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
+		return err
+	}
+	return c.conn.WriteJSON(v)
+}
+
+// startPending starts pipes of new subscriptions, whose IDs have been responded.
+func (c *wsConn) startPending() {
+	c.lock.Lock()
+	pending := c.pending
+	c.pending = nil
+	c.lock.Unlock()
+
+	for _, start := range pending {
+		start()
+	}
+}
+
+// serve reads and handles requests until the connection closed.
+func (c *wsConn) serve() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer func() {
+		cancel()
+		close(c.closed)
+		c.wg.Wait()
+	}()
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		c.pingLoop()
+	}()
+
+	c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		c.conn.SetReadDeadline(time.Now().Add(pongWait))
+		return nil
+	})
+	for {
+		_, msg, err := c.conn.ReadMessage()
+		if err != nil {
+			log.Debug("websocket read err", "err", err)
+			return
+		}
+		c.conn.SetReadDeadline(time.Now().Add(pongWait))
+		if resp := handleMessage(ctx, msg, c.call); resp != nil {
+			if err := c.write(resp); err != nil {
+				log.Debug("websocket write err", "err", err)
+				return
+			}
+		}
+		c.startPending()
+	}
+}
+
+func (c *wsConn) pingLoop() {
+	ticker := time.NewTicker(pingPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.rpc.done:
+			closeMsg := websocket.FormatCloseMessage(websocket.CloseGoingAway, "")
+			if err := c.conn.WriteControl(websocket.CloseMessage, closeMsg, time.Now().Add(time.Second)); err != nil {
+				log.Debug("write close message", "err", err)
+			}
+			// to break the read loop
+			c.conn.Close()
+			return
+		case <-c.closed:
+			return
+		case <-ticker.C:
+			if err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeWait)); err != nil {
+				log.Debug("write ping message", "err", err)
+			}
+		}
+	}
+}
+
+func (c *wsConn) call(ctx context.Context, req *request) (interface{}, error) {
+	switch req.Method {
+	case "eth_subscribe":
+		return c.subscribe(req.Params)
+	case "eth_unsubscribe":
+		return c.unsubscribe(req.Params)
+	default:
+		return c.rpc.call(ctx, req)
+	}
+}
+
+func (c *wsConn) subscribe(params json.RawMessage) (interface{}, error) {
+	var (
+		kind   string
+		filter FilterQuery
+	)
+	if err := parseParams(params, &kind, &filter); err != nil {
+		return nil, err
+	}
+
+	// new blocks are read from the current best block
+	blockReader := c.rpc.repo.NewBlockReader(c.rpc.repo.BestBlock().Header().ID())
+	var reader msgReader
+	switch kind {
+	case "newHeads":
+		reader = &headsReader{blockReader}
+	case "logs":
+		if len(filter.Topics) > 5 {
+			return nil, invalidParams(errors.New("topics: too many topics"))
+		}
+		reader = &logsReader{c.rpc.repo, &filter, blockReader}
+	default:
+		return nil, invalidParams(errors.New("unsupported subscription: " + kind))
+	}
+
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return nil, err
+	}
+	id := hexutil.Encode(b[:])
+	stop := make(chan struct{})
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if len(c.subs) >= maxSubscriptions {
+		return nil, errors.New("too many subscriptions")
+	}
+	c.subs[id] = stop
+
+	// notifications must not precede the response carrying the subscription ID
+	c.pending = append(c.pending, func() {
+		c.wg.Add(1)
+		go func() {
+			defer c.wg.Done()
+			c.pipe(id, reader, stop)
+		}()
+	})
+	return id, nil
+}
+
+func (c *wsConn) unsubscribe(params json.RawMessage) (interface{}, error) {
+	var id string
+	if err := parseParams(params, &id); err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stop, ok := c.subs[id]
+	if ok {
+		close(stop)
+		delete(c.subs, id)
+	}
+	return ok, nil
+}
+
+// pipe sends messages of the reader as notifications, until unsubscribed or the connection closed.
+// The subscription is removed when the pipe ends.
+func (c *wsConn) pipe(id string, reader msgReader, stop chan struct{}) {
+	defer func() {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		if c.subs[id] == stop {
+			delete(c.subs, id)
+		}
+	}()
+
+	ticker := c.rpc.repo.NewTicker()
+	for {
+		msgs, hasMore, err := reader.Read()
+		if err != nil {
+			log.Debug("read subscription", "id", id, "err", err)
+			return
+		}
+		for _, msg := range msgs {
+			if err := c.write(&notification{
+				Version: version,
+				Method:  "eth_subscription",
+				Params:  notificationParams{id, msg},
+			}); err != nil {
+				log.Debug("websocket write err", "err", err)
+				// to break the read loop
+				c.conn.Close()
+				return
+			}
+		}
+		if hasMore {
+			select {
+			case <-c.rpc.done:
+				return
+			case <-c.closed:
+				return
+			case <-stop:
+				return
+			default:
+			}
+		} else {
+			select {
+			case <-c.rpc.done:
+				return
+			case <-c.closed:
+				return
+			case <-stop:
+				return
+			case <-ticker.C():
+			}
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package jsonrpc
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/state"
+)
+
+type errReader struct{}
+
+func (errReader) Read() ([]interface{}, bool, error) {
+	return nil, false, errors.New("read error")
+}
+
+func TestSubscribe(t *testing.T) {
+	initServer(t)
+	defer ts.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http")+"/jsonrpc", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	call := func(method string, params ...interface{}) *response {
+		if err := conn.WriteJSON(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      1,
+			"method":  method,
+			"params":  params,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		var resp response
+		if err := conn.ReadJSON(&resp); err != nil {
+			t.Fatal(err)
+		}
+		return &resp
+	}
+
+	resp := call("eth_subscribe", "newHeads")
+	assert.Nil(t, resp.Error)
+	var id string
+	assert.Nil(t, json.Unmarshal(resp.Result, &id))
+	assert.NotEmpty(t, id)
+
+	resp = call("eth_subscribe", "foo")
+	assert.Equal(t, codeInvalidParams, resp.Error.Code)
+
+	var ok bool
+	assert.Nil(t, json.Unmarshal(call("eth_unsubscribe", id).Result, &ok))
+	assert.True(t, ok)
+	assert.Nil(t, json.Unmarshal(call("eth_unsubscribe", id).Result, &ok))
+	assert.False(t, ok)
+}
+
+func TestPipeRemovesSubscription(t *testing.T) {
+	db := muxdb.NewMem()
+	b, _, _, err := genesis.NewDevnet().Build(state.NewStater(db))
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, _ := chain.NewRepository(db, b)
+
+	c := newWSConn(&JSONRPC{repo: repo, done: make(chan struct{})}, nil)
+	stop := make(chan struct{})
+	c.subs["0x1"] = stop
+
+	// the pipe ends on read error, and the slot is released
+	c.pipe("0x1", errReader{}, stop)
+	assert.Empty(t, c.subs)
+}
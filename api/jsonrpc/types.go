@@ -0,0 +1,437 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package jsonrpc
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+const version = "2.0"
+
+// standard and implementation-defined error codes.
+const (
+	codeParseError        = -32700
+	codeInvalidRequest    = -32600
+	codeMethodNotFound    = -32601
+	codeInvalidParams     = -32602
+	codeServerError       = -32000
+	codeExecutionReverted = 3
+)
+
+var (
+	// keccak256 of rlp encoded empty list, which is the uncles hash of blocks without uncles.
+	emptyUnclesHash = thor.MustParseBytes32("0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347")
+	emptyBloom      = make(hexutil.Bytes, 256)
+	emptyNonce      = make(hexutil.Bytes, 8)
+)
+
+type request struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+// isNotification returns whether the request expects no response.
+func (r *request) isNotification() bool {
+	return len(r.ID) == 0
+}
+
+type response struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type notification struct {
+	Version string             `json:"jsonrpc"`
+	Method  string             `json:"method"`
+	Params  notificationParams `json:"params"`
+}
+
+type notificationParams struct {
+	Subscription string      `json:"subscription"`
+	Result       interface{} `json:"result"`
+}
+
+type rpcError struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+func (e *rpcError) Error() string {
+	return e.Message
+}
+
+func invalidParams(err error) error {
+	return &rpcError{Code: codeInvalidParams, Message: err.Error()}
+}
+
+// parseParams decodes positional params into args.
+// Trailing params are optional, and the corresponding args are left untouched.
+func parseParams(raw json.RawMessage, args ...interface{}) error {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	var params []json.RawMessage
+	if err := json.Unmarshal(raw, &params); err != nil {
+		return invalidParams(errors.New("params: should be array"))
+	}
+	if len(params) > len(args) {
+		return invalidParams(errors.Errorf("params: too many arguments, want at most %d", len(args)))
+	}
+	for i, param := range params {
+		if err := json.Unmarshal(param, args[i]); err != nil {
+			return invalidParams(errors.WithMessage(err, "params"))
+		}
+	}
+	return nil
+}
+
+// addressList accepts either a single address or an array of addresses.
+type addressList []thor.Address
+
+func (l *addressList) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*l = nil
+		return nil
+	}
+	if len(data) > 0 && data[0] == '[' {
+		var addrs []thor.Address
+		if err := json.Unmarshal(data, &addrs); err != nil {
+			return err
+		}
+		*l = addrs
+		return nil
+	}
+	var addr thor.Address
+	if err := json.Unmarshal(data, &addr); err != nil {
+		return err
+	}
+	*l = addressList{addr}
+	return nil
+}
+
+// topicList accepts null (wildcard), a single topic or an array of alternative topics.
+type topicList []thor.Bytes32
+
+func (l *topicList) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*l = nil
+		return nil
+	}
+	if len(data) > 0 && data[0] == '[' {
+		var topics []thor.Bytes32
+		if err := json.Unmarshal(data, &topics); err != nil {
+			return err
+		}
+		*l = topics
+		return nil
+	}
+	var topic thor.Bytes32
+	if err := json.Unmarshal(data, &topic); err != nil {
+		return err
+	}
+	*l = topicList{topic}
+	return nil
+}
+
+// FilterQuery is the filter object of eth_getLogs and eth_subscribe("logs").
+type FilterQuery struct {
+	BlockHash *thor.Bytes32 `json:"blockHash"`
+	FromBlock string        `json:"fromBlock"`
+	ToBlock   string        `json:"toBlock"`
+	Address   addressList   `json:"address"`
+	Topics    []topicList   `json:"topics"`
+}
+
+// Match returns whether the event matches the address and topics of the filter.
+func (q *FilterQuery) Match(event *tx.Event) bool {
+	if len(q.Address) > 0 {
+		matched := false
+		for _, addr := range q.Address {
+			if addr == event.Address {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	for i, alternatives := range q.Topics {
+		if len(alternatives) == 0 {
+			continue
+		}
+		if i >= len(event.Topics) {
+			return false
+		}
+		matched := false
+		for _, topic := range alternatives {
+			if topic == event.Topics[i] {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// CallArgs is the call object of eth_call and eth_estimateGas.
+type CallArgs struct {
+	From     *thor.Address   `json:"from"`
+	To       *thor.Address   `json:"to"`
+	Gas      *hexutil.Uint64 `json:"gas"`
+	GasPrice *hexutil.Big    `json:"gasPrice"`
+	Value    *hexutil.Big    `json:"value"`
+	Data     *hexutil.Bytes  `json:"data"`
+	Input    *hexutil.Bytes  `json:"input"`
+}
+
+func (a *CallArgs) data() []byte {
+	if a.Input != nil {
+		return *a.Input
+	}
+	if a.Data != nil {
+		return *a.Data
+	}
+	return nil
+}
+
+// Header is the ethereum styled block header.
+type Header struct {
+	Number           hexutil.Uint64 `json:"number"`
+	Hash             thor.Bytes32   `json:"hash"`
+	ParentHash       thor.Bytes32   `json:"parentHash"`
+	Nonce            hexutil.Bytes  `json:"nonce"`
+	Sha3Uncles       thor.Bytes32   `json:"sha3Uncles"`
+	LogsBloom        hexutil.Bytes  `json:"logsBloom"`
+	TransactionsRoot thor.Bytes32   `json:"transactionsRoot"`
+	StateRoot        thor.Bytes32   `json:"stateRoot"`
+	ReceiptsRoot     thor.Bytes32   `json:"receiptsRoot"`
+	Miner            thor.Address   `json:"miner"`
+	Difficulty       hexutil.Uint64 `json:"difficulty"`
+	TotalDifficulty  hexutil.Uint64 `json:"totalDifficulty"`
+	ExtraData        hexutil.Bytes  `json:"extraData"`
+	Size             hexutil.Uint64 `json:"size"`
+	GasLimit         hexutil.Uint64 `json:"gasLimit"`
+	GasUsed          hexutil.Uint64 `json:"gasUsed"`
+	Timestamp        hexutil.Uint64 `json:"timestamp"`
+}
+
+// Block is the ethereum styled block.
+// Transactions contains either tx hashes or full tx objects.
+type Block struct {
+	*Header
+	Transactions []interface{}  `json:"transactions"`
+	Uncles       []thor.Bytes32 `json:"uncles"`
+}
+
+func convertHeader(b *block.Block) *Header {
+	header := b.Header()
+	return &Header{
+		Number:           hexutil.Uint64(header.Number()),
+		Hash:             header.ID(),
+		ParentHash:       header.ParentID(),
+		Nonce:            emptyNonce,
+		Sha3Uncles:       emptyUnclesHash,
+		LogsBloom:        emptyBloom,
+		TransactionsRoot: header.TxsRoot(),
+		StateRoot:        header.StateRoot(),
+		ReceiptsRoot:     header.ReceiptsRoot(),
+		Miner:            header.Beneficiary(),
+		TotalDifficulty:  hexutil.Uint64(header.TotalScore()),
+		ExtraData:        hexutil.Bytes{},
+		Size:             hexutil.Uint64(b.Size()),
+		GasLimit:         hexutil.Uint64(header.GasLimit()),
+		GasUsed:          hexutil.Uint64(header.GasUsed()),
+		Timestamp:        hexutil.Uint64(header.Timestamp()),
+	}
+}
+
+// Clause is the clause of a transaction.
+type Clause struct {
+	To    *thor.Address `json:"to"`
+	Value *hexutil.Big  `json:"value"`
+	Data  hexutil.Bytes `json:"data"`
+}
+
+// Transaction is the ethereum styled transaction, with thor specific fields appended.
+type Transaction struct {
+	Hash             thor.Bytes32    `json:"hash"`
+	Nonce            hexutil.Uint64  `json:"nonce"`
+	BlockHash        *thor.Bytes32   `json:"blockHash"`
+	BlockNumber      *hexutil.Uint64 `json:"blockNumber"`
+	TransactionIndex *hexutil.Uint64 `json:"transactionIndex"`
+	From             thor.Address    `json:"from"`
+	To               *thor.Address   `json:"to"`
+	Value            *hexutil.Big    `json:"value"`
+	Gas              hexutil.Uint64  `json:"gas"`
+	GasPrice         *hexutil.Big    `json:"gasPrice"`
+	Input            hexutil.Bytes   `json:"input"`
+
+	ChainTag     hexutil.Uint64 `json:"chainTag"`
+	GasPriceCoef hexutil.Uint64 `json:"gasPriceCoef"`
+	Clauses      []*Clause      `json:"clauses"`
+	Delegator    *thor.Address  `json:"delegator"`
+}
+
+// convertTransaction converts tx into ethereum styled tx.
+// The first clause is taken as the 'to', 'value' and 'input' of the tx.
+func convertTransaction(t *tx.Transaction, header *block.Header, index uint64, baseGasPrice *big.Int) *Transaction {
+	origin, _ := t.Origin()
+	delegator, _ := t.Delegator()
+
+	clauses := make([]*Clause, len(t.Clauses()))
+	for i, c := range t.Clauses() {
+		clauses[i] = &Clause{
+			To:    c.To(),
+			Value: (*hexutil.Big)(c.Value()),
+			Data:  c.Data(),
+		}
+	}
+
+	ethTx := &Transaction{
+		Hash:         t.ID(),
+		Nonce:        hexutil.Uint64(t.Nonce()),
+		From:         origin,
+		Value:        (*hexutil.Big)(new(big.Int)),
+		Gas:          hexutil.Uint64(t.Gas()),
+		GasPrice:     (*hexutil.Big)(t.GasPrice(baseGasPrice)),
+		Input:        hexutil.Bytes{},
+		ChainTag:     hexutil.Uint64(t.ChainTag()),
+		GasPriceCoef: hexutil.Uint64(t.GasPriceCoef()),
+		Clauses:      clauses,
+		Delegator:    delegator,
+	}
+	if len(clauses) > 0 {
+		ethTx.To = clauses[0].To
+		ethTx.Value = clauses[0].Value
+		ethTx.Input = clauses[0].Data
+	}
+	if header != nil {
+		id := header.ID()
+		num := hexutil.Uint64(header.Number())
+		idx := hexutil.Uint64(index)
+		ethTx.BlockHash = &id
+		ethTx.BlockNumber = &num
+		ethTx.TransactionIndex = &idx
+	}
+	return ethTx
+}
+
+// Log is the ethereum styled event log.
+type Log struct {
+	Address          thor.Address   `json:"address"`
+	Topics           []thor.Bytes32 `json:"topics"`
+	Data             hexutil.Bytes  `json:"data"`
+	BlockNumber      hexutil.Uint64 `json:"blockNumber"`
+	BlockHash        thor.Bytes32   `json:"blockHash"`
+	TransactionHash  thor.Bytes32   `json:"transactionHash"`
+	TransactionIndex hexutil.Uint64 `json:"transactionIndex"`
+	LogIndex         hexutil.Uint64 `json:"logIndex"`
+	Removed          bool           `json:"removed"`
+}
+
+// Receipt is the ethereum styled tx receipt, with thor specific fields appended.
+type Receipt struct {
+	TransactionHash   thor.Bytes32   `json:"transactionHash"`
+	TransactionIndex  hexutil.Uint64 `json:"transactionIndex"`
+	BlockHash         thor.Bytes32   `json:"blockHash"`
+	BlockNumber       hexutil.Uint64 `json:"blockNumber"`
+	From              thor.Address   `json:"from"`
+	To                *thor.Address  `json:"to"`
+	CumulativeGasUsed hexutil.Uint64 `json:"cumulativeGasUsed"`
+	GasUsed           hexutil.Uint64 `json:"gasUsed"`
+	ContractAddress   *thor.Address  `json:"contractAddress"`
+	Logs              []*Log         `json:"logs"`
+	LogsBloom         hexutil.Bytes  `json:"logsBloom"`
+	Status            hexutil.Uint64 `json:"status"`
+
+	GasPayer thor.Address `json:"gasPayer"`
+	Paid     *hexutil.Big `json:"paid"`
+	Reward   *hexutil.Big `json:"reward"`
+}
+
+// convertReceipt converts the receipt of the index-th tx in block into ethereum styled receipt.
+func convertReceipt(b *block.Block, receipts tx.Receipts, index int) *Receipt {
+	var (
+		header   = b.Header()
+		t        = b.Transactions()[index]
+		r        = receipts[index]
+		logIndex uint64
+		cumGas   uint64
+	)
+	origin, _ := t.Origin()
+	for _, prev := range receipts[:index] {
+		cumGas += prev.GasUsed
+		for _, output := range prev.Outputs {
+			logIndex += uint64(len(output.Events))
+		}
+	}
+
+	receipt := &Receipt{
+		TransactionHash:   t.ID(),
+		TransactionIndex:  hexutil.Uint64(index),
+		BlockHash:         header.ID(),
+		BlockNumber:       hexutil.Uint64(header.Number()),
+		From:              origin,
+		CumulativeGasUsed: hexutil.Uint64(cumGas + r.GasUsed),
+		GasUsed:           hexutil.Uint64(r.GasUsed),
+		Logs:              []*Log{},
+		LogsBloom:         emptyBloom,
+		GasPayer:          r.GasPayer,
+		Paid:              (*hexutil.Big)(r.Paid),
+		Reward:            (*hexutil.Big)(r.Reward),
+	}
+	if !r.Reverted {
+		receipt.Status = 1
+	}
+
+	for i, clause := range t.Clauses() {
+		if i == 0 {
+			receipt.To = clause.To()
+		}
+		if clause.To() == nil && receipt.ContractAddress == nil && !r.Reverted {
+			addr := thor.CreateContractAddress(t.ID(), uint32(i), 0)
+			receipt.ContractAddress = &addr
+		}
+	}
+
+	for _, output := range r.Outputs {
+		for _, event := range output.Events {
+			receipt.Logs = append(receipt.Logs, convertLog(header, t.ID(), uint64(index), logIndex, event, false))
+			logIndex++
+		}
+	}
+	return receipt
+}
+
+func convertLog(header *block.Header, txID thor.Bytes32, txIndex, logIndex uint64, event *tx.Event, removed bool) *Log {
+	return &Log{
+		Address:          event.Address,
+		Topics:           append([]thor.Bytes32{}, event.Topics...),
+		Data:             event.Data,
+		BlockNumber:      hexutil.Uint64(header.Number()),
+		BlockHash:        header.ID(),
+		TransactionHash:  txID,
+		TransactionIndex: hexutil.Uint64(txIndex),
+		LogIndex:         hexutil.Uint64(logIndex),
+		Removed:          removed,
+	}
+}
@@ -7,18 +7,44 @@ package node
 
 import (
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
 	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/liveness"
+	"github.com/vechain/thor/poa"
+	"github.com/vechain/thor/reorg"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+)
+
+const (
+	defaultScheduleCount = 10
+	maxScheduleCount     = 1000
+	defaultReorgsLimit   = 10
+	maxReorgsLimit       = 100
 )
 
 type Node struct {
-	nw Network
+	nw      Network
+	repo    *chain.Repository
+	stater  *state.Stater
+	tracker *liveness.Tracker
+	reorgs  *reorg.Journal
 }
 
-func New(nw Network) *Node {
+// New creates the node API. The tracker can be nil if proposers are not tracked.
+func New(nw Network, repo *chain.Repository, stater *state.Stater, tracker *liveness.Tracker, reorgs *reorg.Journal) *Node {
 	return &Node{
 		nw,
+		repo,
+		stater,
+		tracker,
+		reorgs,
 	}
 }
 
@@ -30,8 +56,155 @@ func (n *Node) handleNetwork(w http.ResponseWriter, req *http.Request) error {
 	return utils.WriteJSON(w, n.PeersStats())
 }
 
+// schedule computes proposers of count slots upon the best block, starting from the first slot not before `from`.
+// If proposer is given, it's scheduled even if inactive, as it would be when it produces blocks.
+// Every slot after the best block, including those before `from`, is assumed to produce a block,
+// so the schedule is accurate only as long as no slot is missed.
+func (n *Node) schedule(from uint64, count int, proposer *thor.Address) ([]*Slot, error) {
+	best := n.repo.BestBlock().Header()
+	st := n.stater.NewState(best.StateRoot())
+
+	list, err := builtin.Authority.Native(st).AllCandidates()
+	if err != nil {
+		return nil, err
+	}
+	proposers, err := poa.NewCandidates(list).Pick(st)
+	if err != nil {
+		return nil, err
+	}
+	if len(proposers) == 0 {
+		return []*Slot{}, nil
+	}
+
+	var addr thor.Address
+	if proposer != nil {
+		addr = *proposer
+	} else {
+		for _, p := range proposers {
+			if p.Active {
+				addr = p.Address
+				break
+			}
+		}
+		if addr.IsZero() && len(proposers) > 0 {
+			// all inactive
+			addr = proposers[0].Address
+		}
+	}
+
+	active := make(map[thor.Address]bool, len(proposers))
+	for _, p := range proposers {
+		active[p.Address] = p.Active
+	}
+
+	t := best.Timestamp() + thor.BlockInterval
+	parentNum := best.Number()
+	if from > t {
+		// align to slot, and skipped slots produce blocks too
+		skipped := (from - t + thor.BlockInterval - 1) / thor.BlockInterval
+		t += skipped * thor.BlockInterval
+		parentNum += uint32(skipped)
+	}
+	slots := make([]*Slot, 0, count)
+	for i := 0; i < count; i++ {
+		// the block of the previous slot is the parent
+		sched, err := poa.NewScheduler(addr, proposers, parentNum, t-thor.BlockInterval)
+		if err != nil {
+			return nil, utils.BadRequest(errors.WithMessage(err, "proposer"))
+		}
+		p := sched.WhoseTurn(t).Address
+		slots = append(slots, &Slot{
+			Timestamp: t,
+			Proposer:  p,
+			Active:    active[p],
+		})
+		// an inactive proposer is activated by producing a block
+		active[p] = true
+		parentNum++
+		t += thor.BlockInterval
+	}
+	return slots, nil
+}
+
+func (n *Node) handleSchedule(w http.ResponseWriter, req *http.Request) error {
+	from := uint64(time.Now().Unix())
+	if s := req.URL.Query().Get("from"); s != "" {
+		v, err := strconv.ParseUint(s, 0, 64)
+		if err != nil {
+			return utils.BadRequest(errors.WithMessage(err, "from"))
+		}
+		from = v
+	}
+	count := defaultScheduleCount
+	if s := req.URL.Query().Get("count"); s != "" {
+		v, err := strconv.ParseUint(s, 0, 0)
+		if err != nil {
+			return utils.BadRequest(errors.WithMessage(err, "count"))
+		}
+		if v > maxScheduleCount {
+			return utils.BadRequest(errors.Errorf("count: exceeds limit %d", maxScheduleCount))
+		}
+		count = int(v)
+	}
+	var proposer *thor.Address
+	if s := req.URL.Query().Get("proposer"); s != "" {
+		addr, err := thor.ParseAddress(s)
+		if err != nil {
+			return utils.BadRequest(errors.WithMessage(err, "proposer"))
+		}
+		proposer = &addr
+	}
+	slots, err := n.schedule(from, count, proposer)
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, slots)
+}
+
+func (n *Node) handleProposers(w http.ResponseWriter, req *http.Request) error {
+	stats := []*ProposerStats{}
+	if n.tracker != nil {
+		stats = ConvertProposersStats(n.tracker.Stats())
+	}
+	return utils.WriteJSON(w, stats)
+}
+
+func (n *Node) handleReorgs(w http.ResponseWriter, req *http.Request) error {
+	limit := uint64(defaultReorgsLimit)
+	if s := req.URL.Query().Get("limit"); s != "" {
+		v, err := strconv.ParseUint(s, 0, 0)
+		if err != nil {
+			return utils.BadRequest(errors.WithMessage(err, "limit"))
+		}
+		if v > maxReorgsLimit {
+			return utils.BadRequest(errors.Errorf("limit: exceeds limit %d", maxReorgsLimit))
+		}
+		limit = v
+	}
+	// the latest ones by default
+	var from uint64
+	if next := n.reorgs.NextSeq(); next > limit {
+		from = next - limit
+	}
+	if s := req.URL.Query().Get("from"); s != "" {
+		v, err := strconv.ParseUint(s, 0, 64)
+		if err != nil {
+			return utils.BadRequest(errors.WithMessage(err, "from"))
+		}
+		from = v
+	}
+	reorgs, err := n.reorgs.Range(from, int(limit))
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, ConvertReorgs(reorgs))
+}
+
 func (n *Node) Mount(root *mux.Router, pathPrefix string) {
 	sub := root.PathPrefix(pathPrefix).Subrouter()
 
 	sub.Path("/network/peers").Methods("Get").HandlerFunc(utils.WrapHandlerFunc(n.handleNetwork))
+	sub.Path("/schedule").Methods("Get").HandlerFunc(utils.WrapHandlerFunc(n.handleSchedule))
+	sub.Path("/proposers").Methods("Get").HandlerFunc(utils.WrapHandlerFunc(n.handleProposers))
+	sub.Path("/reorgs").Methods("Get").HandlerFunc(utils.WrapHandlerFunc(n.handleReorgs))
 }
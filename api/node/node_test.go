@@ -9,6 +9,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
 	"time"
 
@@ -19,11 +20,14 @@ import (
 	"github.com/vechain/thor/comm"
 	"github.com/vechain/thor/genesis"
 	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/reorg"
 	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/txpool"
 )
 
 var ts *httptest.Server
+var reorgs *reorg.Journal
 
 func TestNode(t *testing.T) {
 	initCommServer(t)
@@ -35,6 +39,93 @@ func TestNode(t *testing.T) {
 	assert.Equal(t, 0, len(peersStats), "count should be zero")
 }
 
+func TestProposers(t *testing.T) {
+	initCommServer(t)
+	res := httpGet(t, ts.URL+"/node/proposers")
+	var stats []*node.ProposerStats
+	if err := json.Unmarshal(res, &stats); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 0, len(stats), "not tracked")
+}
+
+func TestSchedule(t *testing.T) {
+	initCommServer(t)
+	signer := genesis.DevAccounts()[0].Address
+
+	res := httpGet(t, ts.URL+"/node/schedule?from=0&count=3")
+	var slots []*node.Slot
+	if err := json.Unmarshal(res, &slots); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 3, len(slots))
+	for i, slot := range slots {
+		assert.Equal(t, signer, slot.Proposer, "the only authority")
+		assert.True(t, slot.Active)
+		if i > 0 {
+			assert.Equal(t, slots[i-1].Timestamp+thor.BlockInterval, slot.Timestamp)
+		}
+	}
+
+	// same slots when starting later
+	res = httpGet(t, ts.URL+"/node/schedule?count=1&from="+strconv.FormatUint(slots[2].Timestamp, 10))
+	var later []*node.Slot
+	if err := json.Unmarshal(res, &later); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, slots[2:], later)
+
+	r, err := http.Get(ts.URL + "/node/schedule?proposer=" + genesis.DevAccounts()[1].Address.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, r.StatusCode, "not an authority")
+}
+
+func TestReorgs(t *testing.T) {
+	initCommServer(t)
+	res := httpGet(t, ts.URL+"/node/reorgs")
+	var list []*node.Reorg
+	if err := json.Unmarshal(res, &list); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 0, len(list), "no reorg")
+
+	for i := 0; i < 3; i++ {
+		if err := reorgs.Record(&reorg.Reorg{
+			Timestamp: uint64(i),
+			OldHead:   thor.BytesToBytes32([]byte{byte(i)}),
+			Orphaned:  []thor.Bytes32{thor.BytesToBytes32([]byte{byte(i)})},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	res = httpGet(t, ts.URL+"/node/reorgs?limit=2")
+	if err := json.Unmarshal(res, &list); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, len(list), "the latest ones")
+	assert.Equal(t, uint64(1), list[0].Seq)
+	assert.Equal(t, uint64(2), list[1].Seq)
+
+	res = httpGet(t, ts.URL+"/node/reorgs?from=0&limit=2")
+	if err := json.Unmarshal(res, &list); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, len(list))
+	assert.Equal(t, uint64(0), list[0].Seq)
+	assert.Equal(t, thor.BytesToBytes32([]byte{0}), list[0].OldHead)
+
+	r, err := http.Get(ts.URL + "/node/reorgs?limit=101")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, r.StatusCode, "exceeds limit")
+}
+
 func initCommServer(t *testing.T) {
 	db := muxdb.NewMem()
 	stater := state.NewStater(db)
@@ -49,9 +140,12 @@ func initCommServer(t *testing.T) {
 		Limit:           10000,
 		LimitPerAccount: 16,
 		MaxLifetime:     10 * time.Minute,
-	}))
+	}), 0)
+	if reorgs, err = reorg.New(db); err != nil {
+		t.Fatal(err)
+	}
 	router := mux.NewRouter()
-	node.New(comm).Mount(router, "/node")
+	node.New(comm, repo, stater, nil, reorgs).Mount(router, "/node")
 	ts = httptest.NewServer(router)
 }
 
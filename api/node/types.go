@@ -7,6 +7,8 @@ package node
 
 import (
 	"github.com/vechain/thor/comm"
+	"github.com/vechain/thor/liveness"
+	"github.com/vechain/thor/reorg"
 	"github.com/vechain/thor/thor"
 )
 
@@ -42,3 +44,62 @@ func ConvertPeersStats(ss []*comm.PeerStats) []*PeerStats {
 	}
 	return peersStats
 }
+
+// Slot a block slot with the proposer scheduled.
+type Slot struct {
+	Timestamp uint64       `json:"timestamp"`
+	Proposer  thor.Address `json:"proposer"`
+	Active    bool         `json:"active"` // whether the proposer is active before producing the block of the slot
+}
+
+// ProposerStats stats of a proposer over the recent window.
+type ProposerStats struct {
+	Address    thor.Address `json:"address"`
+	Produced   uint64       `json:"produced"`
+	Missed     uint64       `json:"missed"`
+	LastSeen   uint64       `json:"lastSeen"`
+	LastMissed uint64       `json:"lastMissed"`
+}
+
+func ConvertProposersStats(ss []*liveness.Stats) []*ProposerStats {
+	proposersStats := make([]*ProposerStats, len(ss))
+	for i, s := range ss {
+		proposersStats[i] = &ProposerStats{
+			Address:    s.Address,
+			Produced:   s.Produced,
+			Missed:     s.Missed,
+			LastSeen:   s.LastSeen,
+			LastMissed: s.LastMissed,
+		}
+	}
+	return proposersStats
+}
+
+// Reorg a chain reorganization recorded by the node.
+type Reorg struct {
+	Seq            uint64         `json:"seq"`
+	Timestamp      uint64         `json:"timestamp"`
+	OldHead        thor.Bytes32   `json:"oldHead"`
+	NewHead        thor.Bytes32   `json:"newHead"`
+	CommonAncestor thor.Bytes32   `json:"commonAncestor"`
+	Orphaned       []thor.Bytes32 `json:"orphaned"`
+}
+
+func ConvertReorg(r *reorg.Reorg) *Reorg {
+	return &Reorg{
+		Seq:            r.Seq,
+		Timestamp:      r.Timestamp,
+		OldHead:        r.OldHead,
+		NewHead:        r.NewHead,
+		CommonAncestor: r.CommonAncestor,
+		Orphaned:       r.Orphaned,
+	}
+}
+
+func ConvertReorgs(rs []*reorg.Reorg) []*Reorg {
+	reorgs := make([]*Reorg, len(rs))
+	for i, r := range rs {
+		reorgs[i] = ConvertReorg(r)
+	}
+	return reorgs
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package pool
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/txpool"
+)
+
+type Pool struct {
+	txPool *txpool.TxPool
+}
+
+func New(txPool *txpool.TxPool) *Pool {
+	return &Pool{
+		txPool,
+	}
+}
+
+func (p *Pool) handleGetStatus(w http.ResponseWriter, req *http.Request) error {
+	return utils.WriteJSON(w, convertStatus(p.txPool.Status()))
+}
+
+func (p *Pool) handleGetTxs(w http.ResponseWriter, req *http.Request) error {
+	var origin *thor.Address
+	if s := req.URL.Query().Get("origin"); s != "" {
+		addr, err := thor.ParseAddress(s)
+		if err != nil {
+			return utils.BadRequest(errors.WithMessage(err, "origin"))
+		}
+		origin = &addr
+	}
+	infos, err := p.txPool.Inspect(origin)
+	if err != nil {
+		return err
+	}
+	txs := make([]*PendingTx, 0, len(infos))
+	for _, info := range infos {
+		txs = append(txs, convertPendingTx(info))
+	}
+	return utils.WriteJSON(w, txs)
+}
+
+func (p *Pool) handleDeleteTx(w http.ResponseWriter, req *http.Request) error {
+	id, err := thor.ParseBytes32(mux.Vars(req)["id"])
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "id"))
+	}
+	if !p.txPool.RemoveByID(id) {
+		return utils.HTTPError(errors.New("tx not found"), http.StatusNotFound)
+	}
+	return utils.WriteJSON(w, map[string]thor.Bytes32{"id": id})
+}
+
+// Mount mounts the status API, which is cheap enough to be public.
+func (p *Pool) Mount(root *mux.Router, pathPrefix string) {
+	sub := root.PathPrefix(pathPrefix).Subrouter()
+
+	sub.Path("/status").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(p.handleGetStatus))
+}
+
+// MountAdmin mounts the status API, the API to list txs, and the APIs to manipulate the pool.
+// Listing txs evaluates every tx in the pool, so it's only served on the admin listener too.
+func (p *Pool) MountAdmin(root *mux.Router, pathPrefix string) {
+	p.Mount(root, pathPrefix)
+	sub := root.PathPrefix(pathPrefix).Subrouter()
+
+	sub.Path("/txs").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(p.handleGetTxs))
+	sub.Path("/txs/{id}").Methods("DELETE").HandlerFunc(utils.WrapHandlerFunc(p.handleDeleteTx))
+}
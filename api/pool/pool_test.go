@@ -0,0 +1,125 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package pool_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/api/pool"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/txpool"
+)
+
+var ts *httptest.Server
+var txPool *txpool.TxPool
+var transaction *tx.Transaction
+
+func TestPool(t *testing.T) {
+	initPoolServer(t)
+	defer ts.Close()
+
+	var status pool.Status
+	httpDo(t, "GET", ts.URL+"/txpool/status", http.StatusOK, &status)
+	assert.Equal(t, 1, status.Total)
+	assert.Equal(t, 1, status.Remote)
+	assert.Equal(t, 16, status.LimitPerAccount)
+	assert.Equal(t, []*pool.AccountStatus{{Address: genesis.DevAccounts()[0].Address, Count: 1}}, status.Accounts)
+
+	var txs []*pool.PendingTx
+	httpDo(t, "GET", ts.URL+"/txpool/txs", http.StatusOK, &txs)
+	assert.Equal(t, 1, len(txs))
+	assert.Equal(t, transaction.ID(), txs[0].ID)
+	assert.Equal(t, genesis.DevAccounts()[0].Address, txs[0].Origin)
+	assert.False(t, txs[0].Executable)
+	assert.Equal(t, "dependsOn not met", txs[0].Reason)
+
+	httpDo(t, "GET", ts.URL+"/txpool/txs?origin="+genesis.DevAccounts()[1].Address.String(), http.StatusOK, &txs)
+	assert.Equal(t, 0, len(txs))
+
+	httpDo(t, "GET", ts.URL+"/txpool/txs?origin=0x", http.StatusBadRequest, nil)
+
+	// only status is served publicly
+	router := mux.NewRouter()
+	pool.New(txPool).Mount(router, "/txpool")
+	public := httptest.NewServer(router)
+	defer public.Close()
+	httpDo(t, "GET", public.URL+"/txpool/status", http.StatusOK, nil)
+	httpDo(t, "GET", public.URL+"/txpool/txs", http.StatusNotFound, nil)
+
+	httpDo(t, "DELETE", ts.URL+"/txpool/txs/"+transaction.ID().String(), http.StatusOK, nil)
+	httpDo(t, "DELETE", ts.URL+"/txpool/txs/"+transaction.ID().String(), http.StatusNotFound, nil)
+	httpDo(t, "GET", ts.URL+"/txpool/status", http.StatusOK, &status)
+	assert.Equal(t, 0, status.Total)
+}
+
+func initPoolServer(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	gene := genesis.NewDevnet()
+
+	b, _, _, err := gene.Build(stater)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, _ := chain.NewRepository(db, b)
+	addr := thor.BytesToAddress([]byte("to"))
+	transaction = new(tx.Builder).
+		ChainTag(repo.ChainTag()).
+		Expiration(10).
+		Gas(21000).
+		Nonce(1).
+		Clause(tx.NewClause(&addr)).
+		DependsOn(&thor.Bytes32{1}).
+		Build()
+	sig, err := crypto.Sign(transaction.SigningHash().Bytes(), genesis.DevAccounts()[0].PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transaction = transaction.WithSignature(sig)
+
+	txPool = txpool.New(repo, stater, txpool.Options{Limit: 10000, LimitPerAccount: 16, MaxLifetime: 10 * time.Minute})
+	if err := txPool.Add(transaction); err != nil {
+		t.Fatal(err)
+	}
+	router := mux.NewRouter()
+	pool.New(txPool).MountAdmin(router, "/txpool")
+	ts = httptest.NewServer(router)
+}
+
+func httpDo(t *testing.T, method string, url string, expectedStatus int, result interface{}) {
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, expectedStatus, res.StatusCode, string(data))
+	if result != nil {
+		if err := json.Unmarshal(data, result); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
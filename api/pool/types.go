@@ -0,0 +1,92 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package pool
+
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/txpool"
+)
+
+// Status summary of the tx pool.
+type Status struct {
+	Total           int              `json:"total"`
+	Executable      int              `json:"executable"`
+	NonExecutable   int              `json:"nonExecutable"`
+	Local           int              `json:"local"`
+	Remote          int              `json:"remote"`
+	LimitPerAccount int              `json:"limitPerAccount"`
+	Accounts        []*AccountStatus `json:"accounts"`
+}
+
+// AccountStatus count of txs sent by the account.
+type AccountStatus struct {
+	Address thor.Address `json:"address"`
+	Count   int          `json:"count"`
+}
+
+func convertStatus(status *txpool.Status) *Status {
+	accounts := make([]*AccountStatus, 0, len(status.Accounts))
+	for addr, count := range status.Accounts {
+		accounts = append(accounts, &AccountStatus{addr, count})
+	}
+	// most active accounts first
+	sort.Slice(accounts, func(i, j int) bool {
+		if accounts[i].Count != accounts[j].Count {
+			return accounts[i].Count > accounts[j].Count
+		}
+		return accounts[i].Address.String() < accounts[j].Address.String()
+	})
+	return &Status{
+		Total:           status.Total,
+		Executable:      status.Executable,
+		NonExecutable:   status.NonExecutable,
+		Local:           status.Local,
+		Remote:          status.Remote,
+		LimitPerAccount: status.LimitPerAccount,
+		Accounts:        accounts,
+	}
+}
+
+// PendingTx tx in the pool.
+type PendingTx struct {
+	ID              thor.Bytes32          `json:"id"`
+	Origin          thor.Address          `json:"origin"`
+	Delegator       *thor.Address         `json:"delegator"`
+	BlockRef        string                `json:"blockRef"`
+	Expiration      uint32                `json:"expiration"`
+	GasPriceCoef    uint8                 `json:"gasPriceCoef"`
+	Gas             uint64                `json:"gas"`
+	DependsOn       *thor.Bytes32         `json:"dependsOn"`
+	Local           bool                  `json:"local"`
+	TimeAdded       uint64                `json:"timeAdded"`
+	OverallGasPrice *math.HexOrDecimal256 `json:"overallGasPrice"`
+	Executable      bool                  `json:"executable"`
+	Reason          string                `json:"reason"`
+}
+
+func convertPendingTx(info *txpool.TxInfo) *PendingTx {
+	delegator, _ := info.Tx.Delegator()
+	br := info.Tx.BlockRef()
+	return &PendingTx{
+		ID:              info.Tx.ID(),
+		Origin:          info.Origin,
+		Delegator:       delegator,
+		BlockRef:        hexutil.Encode(br[:]),
+		Expiration:      info.Tx.Expiration(),
+		GasPriceCoef:    info.Tx.GasPriceCoef(),
+		Gas:             info.Tx.Gas(),
+		DependsOn:       info.Tx.DependsOn(),
+		Local:           info.Local,
+		TimeAdded:       uint64(info.TimeAdded.Unix()),
+		OverallGasPrice: (*math.HexOrDecimal256)(info.OverallGasPrice),
+		Executable:      info.Executable,
+		Reason:          info.Reason,
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package subscriptions
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/thor"
+)
+
+type beat2Reader struct {
+	repo        *chain.Repository
+	blockReader chain.BlockReader
+	fpRate      float64
+}
+
+func newBeat2Reader(repo *chain.Repository, position thor.Bytes32, fpRate float64) *beat2Reader {
+	return &beat2Reader{
+		repo:        repo,
+		blockReader: repo.NewBlockReader(position),
+		fpRate:      fpRate,
+	}
+}
+
+func (br *beat2Reader) Read() ([]interface{}, bool, error) {
+	blocks, err := br.blockReader.Read()
+	if err != nil {
+		return nil, false, err
+	}
+	var msgs []interface{}
+	for _, block := range blocks {
+		header := block.Header()
+		receipts, err := br.repo.GetBlockReceipts(header.ID())
+		if err != nil {
+			return nil, false, err
+		}
+		txs := block.Transactions()
+		var (
+			addresses = thor.NewBloom2(beat2BloomCapacity, br.fpRate)
+			topics    = thor.NewBloom2(beat2BloomCapacity, br.fpRate)
+			transfers = thor.NewBloom2(beat2BloomCapacity, br.fpRate)
+			txMsgs    = make([]*Beat2TxMessage, 0, len(txs))
+		)
+		for i, receipt := range receipts {
+			// all items of a tx go into its single bloom
+			txBloom := thor.NewBloom2(beat2TxBloomCapacity, br.fpRate)
+			add := func(kind *thor.Bloom2, item []byte) {
+				kind.Add(item)
+				txBloom.Add(item)
+			}
+
+			add(addresses, receipt.GasPayer.Bytes())
+			origin, _ := txs[i].Origin()
+			add(addresses, origin.Bytes())
+			for _, output := range receipt.Outputs {
+				for _, event := range output.Events {
+					add(addresses, event.Address.Bytes())
+					for _, topic := range event.Topics {
+						add(topics, topic.Bytes())
+					}
+				}
+				for _, transfer := range output.Transfers {
+					add(transfers, transfer.Sender.Bytes())
+					add(transfers, transfer.Recipient.Bytes())
+				}
+			}
+			txMsgs = append(txMsgs, &Beat2TxMessage{
+				ID:    txs[i].ID(),
+				Bloom: newBloomMessage(txBloom),
+			})
+		}
+		signer, _ := header.Signer()
+		msgs = append(msgs, &Beat2Message{
+			Number:      header.Number(),
+			ID:          header.ID(),
+			ParentID:    header.ParentID(),
+			Timestamp:   header.Timestamp(),
+			TxsFeatures: uint32(header.TxsFeatures()),
+			GasLimit:    header.GasLimit(),
+			GasUsed:     header.GasUsed(),
+			TxCount:     uint32(len(txs)),
+			Signer:      signer,
+			Beneficiary: header.Beneficiary(),
+			Addresses:   newBloomMessage(addresses),
+			Topics:      newBloomMessage(topics),
+			Transfers:   newBloomMessage(transfers),
+			Txs:         txMsgs,
+			Obsolete:    block.Obsolete,
+		})
+	}
+	return msgs, len(blocks) > 0, nil
+}
+
+func newBloomMessage(bloom *thor.Bloom2) *BloomMessage {
+	return &BloomMessage{
+		Bits: hexutil.Encode(bloom.Bits),
+		K:    uint32(bloom.K),
+	}
+}
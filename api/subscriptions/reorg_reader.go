@@ -0,0 +1,41 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package subscriptions
+
+import (
+	"github.com/vechain/thor/api/node"
+	"github.com/vechain/thor/reorg"
+)
+
+// max count of reorgs to be read at once.
+const reorgReadLimit = 100
+
+// reorgReader reads reorgs from the journal in order of seq.
+// Like txPoolReader, it's driven by new reorgs rather than new blocks.
+type reorgReader struct {
+	journal *reorg.Journal
+	nextSeq uint64
+}
+
+func newReorgReader(journal *reorg.Journal, seq uint64) *reorgReader {
+	return &reorgReader{
+		journal: journal,
+		nextSeq: seq,
+	}
+}
+
+func (rr *reorgReader) Read() ([]interface{}, bool, error) {
+	reorgs, err := rr.journal.Range(rr.nextSeq, reorgReadLimit)
+	if err != nil {
+		return nil, false, err
+	}
+	var msgs []interface{}
+	for _, r := range reorgs {
+		msgs = append(msgs, node.ConvertReorg(r))
+		rr.nextSeq = r.Seq + 1
+	}
+	return msgs, len(reorgs) == reorgReadLimit, nil
+}
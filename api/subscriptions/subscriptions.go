@@ -6,7 +6,10 @@
 package subscriptions
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -17,12 +20,16 @@ import (
 	"github.com/vechain/thor/api/utils"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/reorg"
 	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/txpool"
 )
 
 type Subscriptions struct {
 	backtraceLimit uint32
 	repo           *chain.Repository
+	txPool         *txpool.TxPool
+	reorgs         *reorg.Journal
 	upgrader       *websocket.Upgrader
 	done           chan struct{}
 	wg             sync.WaitGroup
@@ -41,12 +48,27 @@ const (
 	pongWait = 60 * time.Second
 	// Send pings to peer with this period. Must be less than pongWait.
 	pingPeriod = (pongWait * 7) / 10
+	// max count of values of an address or topic set.
+	maxSetSize = 1024
+	// false positive rate of beat2 blooms.
+	defaultBloomFPRate = 0.0001
+	minBloomFPRate     = 1e-9
+	maxBloomFPRate     = 0.5
+	// item capacities of beat2 blooms, which decide the fixed bloom sizes along with the false positive rate.
+	beat2BloomCapacity   = 1024
+	beat2TxBloomCapacity = 32
+	// Time allowed to write a message to the peer.
+	writeWait = 10 * time.Second
+	// buffer size of txpool events pending to be written to a conn.
+	txEventBufferSize = 100
 )
 
-func New(repo *chain.Repository, allowedOrigins []string, backtraceLimit uint32) *Subscriptions {
+func New(repo *chain.Repository, allowedOrigins []string, backtraceLimit uint32, txPool *txpool.TxPool, reorgs *reorg.Journal) *Subscriptions {
 	return &Subscriptions{
 		backtraceLimit: backtraceLimit,
 		repo:           repo,
+		txPool:         txPool,
+		reorgs:         reorgs,
 		upgrader: &websocket.Upgrader{
 			EnableCompression: true,
 			CheckOrigin: func(r *http.Request) bool {
@@ -79,37 +101,25 @@ func (s *Subscriptions) handleEventReader(w http.ResponseWriter, req *http.Reque
 	if err != nil {
 		return nil, err
 	}
-	address, err := parseAddress(req.URL.Query().Get("addr"))
+	query := req.URL.Query()
+	address, err := parseAddressSet(query["addr"])
 	if err != nil {
 		return nil, utils.BadRequest(errors.WithMessage(err, "addr"))
 	}
-	t0, err := parseTopic(req.URL.Query().Get("t0"))
-	if err != nil {
-		return nil, utils.BadRequest(errors.WithMessage(err, "t0"))
-	}
-	t1, err := parseTopic(req.URL.Query().Get("t1"))
-	if err != nil {
-		return nil, utils.BadRequest(errors.WithMessage(err, "t1"))
-	}
-	t2, err := parseTopic(req.URL.Query().Get("t2"))
-	if err != nil {
-		return nil, utils.BadRequest(errors.WithMessage(err, "t2"))
-	}
-	t3, err := parseTopic(req.URL.Query().Get("t3"))
-	if err != nil {
-		return nil, utils.BadRequest(errors.WithMessage(err, "t3"))
-	}
-	t4, err := parseTopic(req.URL.Query().Get("t4"))
-	if err != nil {
-		return nil, utils.BadRequest(errors.WithMessage(err, "t4"))
+	var topics [5][]thor.Bytes32
+	for i := range topics {
+		name := fmt.Sprintf("t%d", i)
+		if topics[i], err = parseTopicSet(query[name]); err != nil {
+			return nil, utils.BadRequest(errors.WithMessage(err, name))
+		}
 	}
 	eventFilter := &EventFilter{
 		Address: address,
-		Topic0:  t0,
-		Topic1:  t1,
-		Topic2:  t2,
-		Topic3:  t3,
-		Topic4:  t4,
+		Topic0:  topics[0],
+		Topic1:  topics[1],
+		Topic2:  topics[2],
+		Topic3:  topics[3],
+		Topic4:  topics[4],
 	}
 	return newEventReader(s.repo, position, eventFilter), nil
 }
@@ -147,13 +157,72 @@ func (s *Subscriptions) handleBeatReader(w http.ResponseWriter, req *http.Reques
 	return newBeatReader(s.repo, position), nil
 }
 
+func (s *Subscriptions) handleBeat2Reader(w http.ResponseWriter, req *http.Request) (*beat2Reader, error) {
+	position, err := s.parsePosition(req.URL.Query().Get("pos"))
+	if err != nil {
+		return nil, err
+	}
+	fpRate := defaultBloomFPRate
+	if v := req.URL.Query().Get("fpRate"); v != "" {
+		if fpRate, err = strconv.ParseFloat(v, 64); err != nil {
+			return nil, utils.BadRequest(errors.WithMessage(err, "fpRate"))
+		}
+		if fpRate < minBloomFPRate || fpRate > maxBloomFPRate {
+			return nil, utils.BadRequest(fmt.Errorf("fpRate: out of range [%v, %v]", minBloomFPRate, maxBloomFPRate))
+		}
+	}
+	return newBeat2Reader(s.repo, position, fpRate), nil
+}
+
+func (s *Subscriptions) handleTxPoolReader(w http.ResponseWriter, req *http.Request) (*txPoolReader, error) {
+	query := req.URL.Query()
+	origin, err := parseAddressSet(query["origin"])
+	if err != nil {
+		return nil, utils.BadRequest(errors.WithMessage(err, "origin"))
+	}
+	to, err := parseAddressSet(query["to"])
+	if err != nil {
+		return nil, utils.BadRequest(errors.WithMessage(err, "to"))
+	}
+	delegator, err := parseAddressSet(query["delegator"])
+	if err != nil {
+		return nil, utils.BadRequest(errors.WithMessage(err, "delegator"))
+	}
+	full := false
+	if v := query.Get("full"); v != "" {
+		if full, err = strconv.ParseBool(v); err != nil {
+			return nil, utils.BadRequest(errors.WithMessage(err, "full"))
+		}
+	}
+	txFilter := &PendingTxFilter{
+		Origin:    origin,
+		To:        to,
+		Delegator: delegator,
+	}
+	return newTxPoolReader(s.txPool, txFilter, full), nil
+}
+
+func (s *Subscriptions) handleReorgReader(w http.ResponseWriter, req *http.Request) (*reorgReader, error) {
+	// only new reorgs by default
+	seq := s.reorgs.NextSeq()
+	if v := req.URL.Query().Get("seq"); v != "" {
+		var err error
+		if seq, err = strconv.ParseUint(v, 0, 64); err != nil {
+			return nil, utils.BadRequest(errors.WithMessage(err, "seq"))
+		}
+	}
+	return newReorgReader(s.reorgs, seq), nil
+}
+
 func (s *Subscriptions) handleSubject(w http.ResponseWriter, req *http.Request) error {
 	s.wg.Add(1)
 	defer s.wg.Done()
 
 	var (
-		reader msgReader
-		err    error
+		reader      msgReader
+		txReader    *txPoolReader
+		reorgReader *reorgReader
+		err         error
 	)
 	switch mux.Vars(req)["subject"] {
 	case "block":
@@ -172,6 +241,18 @@ func (s *Subscriptions) handleSubject(w http.ResponseWriter, req *http.Request)
 		if reader, err = s.handleBeatReader(w, req); err != nil {
 			return err
 		}
+	case "beat2":
+		if reader, err = s.handleBeat2Reader(w, req); err != nil {
+			return err
+		}
+	case "txpool":
+		if txReader, err = s.handleTxPoolReader(w, req); err != nil {
+			return err
+		}
+	case "reorg":
+		if reorgReader, err = s.handleReorgReader(w, req); err != nil {
+			return err
+		}
 	default:
 		return utils.HTTPError(errors.New("not found"), http.StatusNotFound)
 	}
@@ -189,8 +270,17 @@ func (s *Subscriptions) handleSubject(w http.ResponseWriter, req *http.Request)
 		}
 	}()
 
+	switch {
+	case txReader != nil:
+		err = s.pipeTxPool(conn, txReader)
+	case reorgReader != nil:
+		err = s.pipeReorg(conn, reorgReader)
+	default:
+		err = s.pipe(conn, reader)
+	}
+
 	var closeMsg []byte
-	if err := s.pipe(conn, reader); err != nil {
+	if err != nil {
 		closeMsg = websocket.FormatCloseMessage(websocket.CloseInternalServerErr, err.Error())
 	} else {
 		closeMsg = websocket.FormatCloseMessage(websocket.CloseGoingAway, "")
@@ -202,9 +292,10 @@ func (s *Subscriptions) handleSubject(w http.ResponseWriter, req *http.Request)
 	return nil
 }
 
-func (s *Subscriptions) pipe(conn *websocket.Conn, reader msgReader) error {
+// startReadLoop starts the loop to read conn, which handles pong and close events.
+// The returned channel is closed once conn closed.
+func (s *Subscriptions) startReadLoop(conn *websocket.Conn) <-chan struct{} {
 	closed := make(chan struct{})
-	// start read loop to handle close event
 	s.wg.Add(1)
 	go func() {
 		defer s.wg.Done()
@@ -221,6 +312,11 @@ func (s *Subscriptions) pipe(conn *websocket.Conn, reader msgReader) error {
 			}
 		}
 	}()
+	return closed
+}
+
+func (s *Subscriptions) pipe(conn *websocket.Conn, reader msgReader) error {
+	closed := s.startReadLoop(conn)
 	ticker := s.repo.NewTicker()
 	pingTicker := time.NewTicker(pingPeriod)
 	defer pingTicker.Stop()
@@ -258,6 +354,112 @@ func (s *Subscriptions) pipe(conn *websocket.Conn, reader msgReader) error {
 	}
 }
 
+// pipeTxPool pipes pending txs from txpool, until conn closed.
+// A slow conn never blocks txpool, it's closed once its buffer overflows.
+func (s *Subscriptions) pipeTxPool(conn *websocket.Conn, reader *txPoolReader) error {
+	closed := s.startReadLoop(conn)
+
+	txEvCh := make(chan *txpool.TxEvent, txEventBufferSize)
+	overflow := make(chan struct{})
+	forwardDone := make(chan struct{})
+	defer close(forwardDone)
+
+	// forward events in a dedicated goroutine, which never blocks the feed of txpool
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		evCh := make(chan *txpool.TxEvent)
+		sub := reader.txPool.SubscribeTxEvent(evCh)
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-forwardDone:
+				return
+			case <-sub.Err():
+				return
+			case ev := <-evCh:
+				select {
+				case txEvCh <- ev:
+				default:
+					close(overflow)
+					return
+				}
+			}
+		}
+	}()
+
+	pingTicker := time.NewTicker(pingPeriod)
+	defer pingTicker.Stop()
+	for {
+		select {
+		case <-s.done:
+			return nil
+		case <-closed:
+			return nil
+		case <-overflow:
+			return errors.New("tx events overflowed, conn too slow")
+		case <-pingTicker.C:
+			if err := writePing(conn); err != nil {
+				return err
+			}
+		case txEv := <-txEvCh:
+			if msg := reader.convert(txEv); msg != nil {
+				if err := writeJSON(conn, msg); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// pipeReorg pipes reorgs from the journal, until conn closed.
+func (s *Subscriptions) pipeReorg(conn *websocket.Conn, reader *reorgReader) error {
+	closed := s.startReadLoop(conn)
+
+	// created before reading, to not miss any reorg
+	ticker := reader.journal.NewTicker()
+	pingTicker := time.NewTicker(pingPeriod)
+	defer pingTicker.Stop()
+	for {
+		msgs, hasMore, err := reader.Read()
+		if err != nil {
+			return err
+		}
+		for _, msg := range msgs {
+			if err := writeJSON(conn, msg); err != nil {
+				return err
+			}
+		}
+		if hasMore {
+			continue
+		}
+		select {
+		case <-s.done:
+			return nil
+		case <-closed:
+			return nil
+		case <-pingTicker.C:
+			if err := writePing(conn); err != nil {
+				return err
+			}
+		case <-ticker.C():
+		}
+	}
+}
+
+// writeJSON writes the message to conn, with the write deadline set.
+func writeJSON(conn *websocket.Conn, msg interface{}) error {
+	if err := conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
+		return err
+	}
+	return conn.WriteJSON(msg)
+}
+
+// writePing writes a ping message to conn, with the write deadline set.
+func writePing(conn *websocket.Conn) error {
+	return conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeWait))
+}
+
 func (s *Subscriptions) parsePosition(posStr string) (thor.Bytes32, error) {
 	bestID := s.repo.BestBlock().Header().ID()
 	if posStr == "" {
@@ -273,15 +475,51 @@ func (s *Subscriptions) parsePosition(posStr string) (thor.Bytes32, error) {
 	return pos, nil
 }
 
-func parseTopic(t string) (*thor.Bytes32, error) {
-	if t == "" {
-		return nil, nil
+// splitSetValues splits values of a query param, which can be repeated or comma separated.
+func splitSetValues(values []string) (ret []string, err error) {
+	for _, v := range values {
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				ret = append(ret, s)
+			}
+		}
 	}
-	topic, err := thor.ParseBytes32(t)
+	if len(ret) > maxSetSize {
+		return nil, errors.New("too many values")
+	}
+	return
+}
+
+func parseTopicSet(values []string) ([]thor.Bytes32, error) {
+	strs, err := splitSetValues(values)
+	if err != nil {
+		return nil, err
+	}
+	var topics []thor.Bytes32
+	for _, s := range strs {
+		topic, err := thor.ParseBytes32(s)
+		if err != nil {
+			return nil, err
+		}
+		topics = append(topics, topic)
+	}
+	return topics, nil
+}
+
+func parseAddressSet(values []string) ([]thor.Address, error) {
+	strs, err := splitSetValues(values)
 	if err != nil {
 		return nil, err
 	}
-	return &topic, nil
+	var addrs []thor.Address
+	for _, s := range strs {
+		addr, err := thor.ParseAddress(s)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
 }
 
 func parseAddress(addr string) (*thor.Address, error) {
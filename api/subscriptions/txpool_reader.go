@@ -0,0 +1,69 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package subscriptions
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/vechain/thor/api/transactions"
+	"github.com/vechain/thor/txpool"
+)
+
+// max count of txs remembered per conn, to skip events of txs already sent.
+const seenTxsCacheSize = 4096
+
+// states of pending txs, to tell whether a tx event brings any news.
+const (
+	pendingUnknown = iota
+	pendingNonExecutable
+	pendingExecutable
+)
+
+// txPoolReader converts tx events of txpool into pending tx messages.
+// Unlike the other readers, it's driven by txpool events rather than new blocks.
+type txPoolReader struct {
+	txPool *txpool.TxPool
+	filter *PendingTxFilter
+	full   bool
+	seen   *lru.Cache // tx ID => the state last sent
+}
+
+func newTxPoolReader(txPool *txpool.TxPool, filter *PendingTxFilter, full bool) *txPoolReader {
+	seen, _ := lru.New(seenTxsCacheSize)
+	return &txPoolReader{
+		txPool: txPool,
+		filter: filter,
+		full:   full,
+		seen:   seen,
+	}
+}
+
+// convert returns nil if the tx of the event is filtered out, or already sent with the same executable state.
+func (tr *txPoolReader) convert(ev *txpool.TxEvent) *PendingTxMessage {
+	if !tr.filter.Match(ev.Tx) {
+		return nil
+	}
+	state := pendingUnknown
+	if ev.Executable != nil {
+		if *ev.Executable {
+			state = pendingExecutable
+		} else {
+			state = pendingNonExecutable
+		}
+	}
+	if last, ok := tr.seen.Get(ev.Tx.ID()); ok && last.(int) == state {
+		return nil
+	}
+	tr.seen.Add(ev.Tx.ID(), state)
+
+	msg := &PendingTxMessage{
+		ID:         ev.Tx.ID(),
+		Executable: ev.Executable,
+	}
+	if tr.full {
+		msg.Tx = transactions.ConvertTransaction(ev.Tx, nil)
+	}
+	return msg
+}
@@ -8,6 +8,7 @@ package subscriptions
 import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/vechain/thor/api/transactions"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/thor"
@@ -137,30 +138,42 @@ func convertEvent(header *block.Header, tx *tx.Transaction, clauseIndex uint32,
 }
 
 // EventFilter contains options for contract event filtering.
+// Each field matches any of its values, and empty matches all.
 type EventFilter struct {
-	Address *thor.Address // restricts matches to events created by specific contracts
-	Topic0  *thor.Bytes32
-	Topic1  *thor.Bytes32
-	Topic2  *thor.Bytes32
-	Topic3  *thor.Bytes32
-	Topic4  *thor.Bytes32
+	Address []thor.Address // restricts matches to events created by specific contracts
+	Topic0  []thor.Bytes32
+	Topic1  []thor.Bytes32
+	Topic2  []thor.Bytes32
+	Topic3  []thor.Bytes32
+	Topic4  []thor.Bytes32
 }
 
 // Match returs whether event matches filter
 func (ef *EventFilter) Match(event *tx.Event) bool {
-	if (ef.Address != nil) && (*ef.Address != event.Address) {
-		return false
+	if len(ef.Address) > 0 {
+		matched := false
+		for _, addr := range ef.Address {
+			if addr == event.Address {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
 	}
 
-	matchTopic := func(topic *thor.Bytes32, index int) bool {
-		if topic != nil {
+	matchTopic := func(topics []thor.Bytes32, index int) bool {
+		if len(topics) > 0 {
 			if len(event.Topics) <= index {
 				return false
 			}
-
-			if *topic != event.Topics[index] {
-				return false
+			for _, topic := range topics {
+				if topic == event.Topics[index] {
+					return true
+				}
 			}
+			return false
 		}
 		return true
 	}
@@ -205,3 +218,87 @@ type BeatMessage struct {
 	K           uint32       `json:"k"`
 	Obsolete    bool         `json:"obsolete"`
 }
+
+// PendingTxMessage pending tx piped by websocket.
+// Tx is only attached if full tx requested.
+type PendingTxMessage struct {
+	ID         thor.Bytes32              `json:"id"`
+	Executable *bool                     `json:"executable"`
+	Tx         *transactions.Transaction `json:"tx,omitempty"`
+}
+
+// PendingTxFilter contains options for pending tx filtering.
+// Each field matches any of its values, and empty matches all.
+type PendingTxFilter struct {
+	Origin    []thor.Address
+	To        []thor.Address // matches if any clause sent to
+	Delegator []thor.Address
+}
+
+// Match returns whether tx matches filter
+func (pf *PendingTxFilter) Match(tx *tx.Transaction) bool {
+	contains := func(addrs []thor.Address, addr thor.Address) bool {
+		for _, a := range addrs {
+			if a == addr {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(pf.Origin) > 0 {
+		origin, err := tx.Origin()
+		if err != nil || !contains(pf.Origin, origin) {
+			return false
+		}
+	}
+
+	if len(pf.Delegator) > 0 {
+		delegator, err := tx.Delegator()
+		if err != nil || delegator == nil || !contains(pf.Delegator, *delegator) {
+			return false
+		}
+	}
+
+	if len(pf.To) > 0 {
+		for _, clause := range tx.Clauses() {
+			if to := clause.To(); to != nil && contains(pf.To, *to) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// BloomMessage bloom filter in beat2 message.
+type BloomMessage struct {
+	Bits string `json:"bits"`
+	K    uint32 `json:"k"`
+}
+
+// Beat2Message beat v2 piped by websocket.
+// Items of blooms are full bytes of addresses or topics, without leading zeros trimmed.
+type Beat2Message struct {
+	Number      uint32            `json:"number"`
+	ID          thor.Bytes32      `json:"id"`
+	ParentID    thor.Bytes32      `json:"parentID"`
+	Timestamp   uint64            `json:"timestamp"`
+	TxsFeatures uint32            `json:"txsFeatures"`
+	GasLimit    uint64            `json:"gasLimit"`
+	GasUsed     uint64            `json:"gasUsed"`
+	TxCount     uint32            `json:"txCount"`
+	Signer      thor.Address      `json:"signer"`
+	Beneficiary thor.Address      `json:"beneficiary"`
+	Addresses   *BloomMessage     `json:"addresses"` // event emitters, tx origins and gas payers
+	Topics      *BloomMessage     `json:"topics"`    // event topics
+	Transfers   *BloomMessage     `json:"transfers"` // transfer senders and recipients
+	Txs         []*Beat2TxMessage `json:"txs"`
+	Obsolete    bool              `json:"obsolete"`
+}
+
+// Beat2TxMessage tx in beat2 message, with a bloom of all items of the tx.
+type Beat2TxMessage struct {
+	ID    thor.Bytes32  `json:"id"`
+	Bloom *BloomMessage `json:"bloom"`
+}
@@ -6,27 +6,57 @@
 package transactions
 
 import (
+	"context"
+	"math/big"
 	"net/http"
+	"strconv"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
+	"github.com/vechain/thor/abi"
 	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/builtin"
 	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/finality"
+	"github.com/vechain/thor/runtime"
+	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
 	"github.com/vechain/thor/txpool"
+	"github.com/vechain/thor/xenv"
 )
 
+// response header to carry the reason code, if tx is rejected by txpool policy.
+const rejectCodeHeader = "x-reject-code"
+
 type Transactions struct {
-	repo *chain.Repository
-	pool *txpool.TxPool
+	repo         *chain.Repository
+	stater       *state.Stater
+	pool         *txpool.TxPool
+	finality     *finality.Finality
+	callGasLimit uint64
+	forkConfig   thor.ForkConfig
 }
 
-func New(repo *chain.Repository, pool *txpool.TxPool) *Transactions {
+func New(
+	repo *chain.Repository,
+	stater *state.Stater,
+	pool *txpool.TxPool,
+	finality *finality.Finality,
+	callGasLimit uint64,
+	forkConfig thor.ForkConfig,
+) *Transactions {
 	return &Transactions{
 		repo,
+		stater,
 		pool,
+		finality,
+		callGasLimit,
+		forkConfig,
 	}
 }
 
@@ -75,7 +105,7 @@ func (t *Transactions) getTransactionByID(txID thor.Bytes32, head thor.Bytes32,
 		if t.repo.IsNotFound(err) {
 			if allowPending {
 				if pending := t.pool.Get(txID); pending != nil {
-					return convertTransaction(pending, nil), nil
+					return ConvertTransaction(pending, nil), nil
 				}
 			}
 			return nil, nil
@@ -87,7 +117,7 @@ func (t *Transactions) getTransactionByID(txID thor.Bytes32, head thor.Bytes32,
 	if err != nil {
 		return nil, err
 	}
-	return convertTransaction(tx, summary.Header), nil
+	return ConvertTransaction(tx, summary.Header), nil
 }
 
 //GetTransactionReceiptByID get tx's receipt
@@ -111,7 +141,14 @@ func (t *Transactions) getTransactionReceiptByID(txID thor.Bytes32, head thor.By
 		return nil, err
 	}
 
-	return convertReceipt(receipt, summary.Header, tx)
+	jReceipt, err := convertReceipt(receipt, summary.Header, tx)
+	if err != nil {
+		return nil, err
+	}
+	if jReceipt.Meta.Confirmations, jReceipt.Meta.Finalized, err = t.finality.Status(summary.Header); err != nil {
+		return nil, err
+	}
+	return jReceipt, nil
 }
 func (t *Transactions) handleSendTransaction(w http.ResponseWriter, req *http.Request) error {
 	var rawTx *RawTx
@@ -128,6 +165,9 @@ func (t *Transactions) handleSendTransaction(w http.ResponseWriter, req *http.Re
 			return utils.BadRequest(err)
 		}
 		if txpool.IsTxRejected(err) {
+			if code := txpool.RejectCode(err); code != "" {
+				w.Header().Set(rejectCodeHeader, code)
+			}
 			return utils.Forbidden(err)
 		}
 		return err
@@ -201,6 +241,254 @@ func (t *Transactions) handleGetTransactionReceiptByID(w http.ResponseWriter, re
 	return utils.WriteJSON(w, receipt)
 }
 
+func (t *Transactions) handleGetTransactionStatus(w http.ResponseWriter, req *http.Request) error {
+	txID, err := thor.ParseBytes32(mux.Vars(req)["id"])
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "id"))
+	}
+	status, err := t.getTransactionStatus(txID)
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, status)
+}
+
+// getTransactionStatus looks up the tx in the best chain, then in the pool, and finally in the recently dropped txs.
+func (t *Transactions) getTransactionStatus(txID thor.Bytes32) (*TxStatus, error) {
+	meta, err := t.repo.NewBestChain().GetTransactionMeta(txID)
+	if err != nil {
+		if !t.repo.IsNotFound(err) {
+			return nil, err
+		}
+		if t.pool.Get(txID) != nil {
+			if t.pool.IsExecutable(txID) {
+				return &TxStatus{Status: TxStatusExecutable}, nil
+			}
+			return &TxStatus{Status: TxStatusPending}, nil
+		}
+		if dropped := t.pool.GetDropped(txID); dropped != nil {
+			return &TxStatus{
+				Status:    TxStatusDropped,
+				Reason:    dropped.Reason,
+				DroppedAt: uint64(dropped.Time.Unix()),
+			}, nil
+		}
+		return &TxStatus{Status: TxStatusUnknown}, nil
+	}
+
+	summary, err := t.repo.GetBlockSummary(meta.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	status := &TxStatus{
+		Status: TxStatusPacked,
+		Meta: &TxMeta{
+			BlockID:        summary.Header.ID(),
+			BlockNumber:    summary.Header.Number(),
+			BlockTimestamp: summary.Header.Timestamp(),
+		},
+	}
+	if meta.Reverted {
+		status.Status = TxStatusReverted
+	}
+	return status, nil
+}
+
+func (t *Transactions) handleEstimate(w http.ResponseWriter, req *http.Request) error {
+	var estimateReq EstimateRequest
+	if err := utils.ParseJSON(req.Body, &estimateReq); err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "body"))
+	}
+	h, err := utils.GetHeaderByRevision(req.URL.Query().Get("revision"), t.repo, t.finality)
+	if err != nil {
+		return err
+	}
+	result, err := t.estimate(req.Context(), &estimateReq, h)
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, result)
+}
+
+// estimate binary searches the lowest execution gas at which all clauses succeed.
+func (t *Transactions) estimate(ctx context.Context, estimateReq *EstimateRequest, header *block.Header) (*EstimateResult, error) {
+	if len(estimateReq.Clauses) == 0 {
+		return nil, utils.BadRequest(errors.New("clauses: empty"))
+	}
+	clauses := make([]*tx.Clause, len(estimateReq.Clauses))
+	for i, c := range estimateReq.Clauses {
+		var (
+			data []byte
+			err  error
+		)
+		if c.Data != "" {
+			if data, err = hexutil.Decode(c.Data); err != nil {
+				return nil, utils.BadRequest(errors.WithMessage(err, "data["+strconv.Itoa(i)+"]"))
+			}
+		}
+		value := big.Int(c.Value)
+		clauses[i] = tx.NewClause(c.To).WithValue(&value).WithData(data)
+	}
+
+	intrinsicGas, err := tx.IntrinsicGas(clauses...)
+	if err != nil {
+		return nil, utils.BadRequest(errors.WithMessage(err, "clauses"))
+	}
+
+	maxGas := t.callGasLimit
+	if estimateReq.Gas > 0 {
+		if estimateReq.Gas < intrinsicGas {
+			return nil, utils.BadRequest(errors.New("gas: less than intrinsic gas"))
+		}
+		if estimateReq.Gas-intrinsicGas > t.callGasLimit {
+			return nil, utils.Forbidden(errors.New("gas: exceeds limit"))
+		}
+		maxGas = estimateReq.Gas - intrinsicGas
+	}
+
+	baseGasPrice, err := builtin.Params.Native(t.stater.NewState(header.StateRoot())).Get(thor.KeyBaseGasPrice)
+	if err != nil {
+		return nil, err
+	}
+	gasPrice := new(tx.Builder).GasPriceCoef(estimateReq.GasPriceCoef).Build().GasPrice(baseGasPrice)
+
+	txCtx := &xenv.TransactionContext{
+		GasPrice:   gasPrice,
+		ProvedWork: new(big.Int),
+	}
+	if estimateReq.Caller != nil {
+		txCtx.Origin = *estimateReq.Caller
+	}
+	switch {
+	case estimateReq.Delegator != nil:
+		if estimateReq.GasPayer != nil && *estimateReq.GasPayer != *estimateReq.Delegator {
+			return nil, utils.BadRequest(errors.New("delegator: differs from gas payer"))
+		}
+		txCtx.GasPayer = *estimateReq.Delegator
+	case estimateReq.GasPayer != nil:
+		txCtx.GasPayer = *estimateReq.GasPayer
+	default:
+		txCtx.GasPayer = txCtx.Origin
+	}
+
+	newResult := func(execGas uint64, out *execOutput) *EstimateResult {
+		totalGas := intrinsicGas + execGas
+		cost := new(big.Int).Mul(new(big.Int).SetUint64(totalGas), gasPrice)
+		result := &EstimateResult{
+			IntrinsicGas: intrinsicGas,
+			ExecutionGas: execGas,
+			TotalGas:     totalGas,
+			VTHOCost:     (*math.HexOrDecimal256)(cost),
+		}
+		if out.vmErr != nil {
+			result.Reverted = true
+			result.VMError = out.vmErr.Error()
+			if reason, err := abi.UnpackRevert(out.data); err == nil {
+				result.RevertReason = reason
+			}
+		}
+		return result
+	}
+
+	out, err := t.execute(ctx, clauses, maxGas, txCtx, header)
+	if err != nil {
+		return nil, err
+	}
+	if out.vmErr != nil {
+		// no value works
+		return newResult(out.gasUsed, out), nil
+	}
+
+	// the used gas is enough in most cases
+	lo, hi := out.gasUsed, maxGas
+	if lo < hi {
+		loOut, err := t.execute(ctx, clauses, lo, txCtx, header)
+		if err != nil {
+			return nil, err
+		}
+		if loOut.vmErr == nil {
+			return newResult(lo, &execOutput{}), nil
+		}
+	}
+
+	// invariant: execution with hi gas succeeds, and with lo gas fails.
+	for lo+1 < hi {
+		mid := lo + (hi-lo)/2
+		midOut, err := t.execute(ctx, clauses, mid, txCtx, header)
+		if err != nil {
+			return nil, err
+		}
+		if midOut.vmErr == nil {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return newResult(hi, &execOutput{}), nil
+}
+
+type execOutput struct {
+	gasUsed uint64
+	vmErr   error
+	data    []byte
+}
+
+// execute runs clauses on the state of the given block, like executing a tx with the given execution gas.
+// It stops at the first failed clause.
+func (t *Transactions) execute(ctx context.Context, clauses []*tx.Clause, gas uint64, txCtx *xenv.TransactionContext, header *block.Header) (*execOutput, error) {
+	signer, _ := header.Signer()
+	rt := runtime.New(t.repo.NewChain(header.ParentID()), t.stater.NewState(header.StateRoot()),
+		&xenv.BlockContext{
+			Beneficiary: header.Beneficiary(),
+			Signer:      signer,
+			Number:      header.Number(),
+			Time:        header.Timestamp(),
+			GasLimit:    header.GasLimit(),
+			TotalScore:  header.TotalScore(),
+		},
+		t.forkConfig)
+
+	leftOverGas := gas
+	for i, clause := range clauses {
+		exec, interrupt := rt.PrepareClause(clause, uint32(i), leftOverGas, txCtx)
+		resultCh := make(chan interface{}, 1)
+		go func() {
+			out, _, err := exec()
+			if err != nil {
+				resultCh <- err
+				return
+			}
+			resultCh <- out
+		}()
+
+		var out *runtime.Output
+		select {
+		case <-ctx.Done():
+			interrupt()
+			return nil, ctx.Err()
+		case result := <-resultCh:
+			if err, ok := result.(error); ok {
+				return nil, err
+			}
+			out = result.(*runtime.Output)
+		}
+
+		used := leftOverGas - out.LeftOverGas
+		leftOverGas = out.LeftOverGas
+		// apply refund counter, capped to half of the used gas, as the runtime does.
+		refund := used / 2
+		if refund > out.RefundGas {
+			refund = out.RefundGas
+		}
+		leftOverGas += refund
+
+		if out.VMErr != nil {
+			return &execOutput{gas - leftOverGas, out.VMErr, out.Data}, nil
+		}
+	}
+	return &execOutput{gasUsed: gas - leftOverGas}, nil
+}
+
 func (t *Transactions) parseHead(head string) (thor.Bytes32, error) {
 	if head == "" {
 		return t.repo.BestBlock().Header().ID(), nil
@@ -216,6 +504,8 @@ func (t *Transactions) Mount(root *mux.Router, pathPrefix string) {
 	sub := root.PathPrefix(pathPrefix).Subrouter()
 
 	sub.Path("").Methods("POST").HandlerFunc(utils.WrapHandlerFunc(t.handleSendTransaction))
+	sub.Path("/estimate").Methods("POST").HandlerFunc(utils.WrapHandlerFunc(t.handleEstimate))
 	sub.Path("/{id}").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(t.handleGetTransactionByID))
 	sub.Path("/{id}/receipt").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(t.handleGetTransactionReceiptByID))
+	sub.Path("/{id}/status").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(t.handleGetTransactionStatus))
 }
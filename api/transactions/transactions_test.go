@@ -16,12 +16,14 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"github.com/vechain/thor/api/transactions"
 	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/finality"
 	"github.com/vechain/thor/genesis"
 	"github.com/vechain/thor/muxdb"
 	"github.com/vechain/thor/packer"
@@ -41,6 +43,8 @@ func TestTransaction(t *testing.T) {
 	getTx(t)
 	getTxReceipt(t)
 	senTx(t)
+	estimate(t)
+	txStatus(t)
 }
 
 func getTx(t *testing.T) {
@@ -70,6 +74,8 @@ func getTxReceipt(t *testing.T) {
 		t.Fatal(err)
 	}
 	assert.Equal(t, uint64(receipt.GasUsed), transaction.Gas(), "gas should be equal")
+	assert.Equal(t, uint32(0), receipt.Meta.Confirmations, "in the best block")
+	assert.False(t, receipt.Meta.Finalized)
 }
 
 func senTx(t *testing.T) {
@@ -102,6 +108,97 @@ func senTx(t *testing.T) {
 	assert.Equal(t, tx.ID().String(), txObj["id"], "should be the same transaction id")
 }
 
+func estimate(t *testing.T) {
+	to := thor.BytesToAddress([]byte("to"))
+	caller := genesis.DevAccounts()[0].Address
+	clause := transactions.Clause{To: &to, Value: math.HexOrDecimal256(*big.NewInt(1))}
+
+	res := httpPost(t, ts.URL+"/transactions/estimate", transactions.EstimateRequest{
+		Clauses:      transactions.Clauses{clause},
+		Caller:       &caller,
+		GasPriceCoef: 255,
+	})
+	var result transactions.EstimateResult
+	if err := json.Unmarshal(res, &result); err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, result.Reverted)
+	assert.Equal(t, uint64(21000), result.IntrinsicGas)
+	assert.Equal(t, uint64(0), result.ExecutionGas)
+	assert.Equal(t, uint64(21000), result.TotalGas)
+	// gas price is doubled by coef 255
+	cost := new(big.Int).Mul(big.NewInt(21000), new(big.Int).Mul(thor.InitialBaseGasPrice, big.NewInt(2)))
+	assert.Equal(t, cost, (*big.Int)(result.VTHOCost))
+
+	// gas paid by delegator
+	delegator := genesis.DevAccounts()[1].Address
+	res = httpPost(t, ts.URL+"/transactions/estimate", transactions.EstimateRequest{
+		Clauses:   transactions.Clauses{clause},
+		Caller:    &caller,
+		Delegator: &delegator,
+	})
+	result = transactions.EstimateResult{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, result.Reverted)
+	assert.Equal(t, uint64(21000), result.TotalGas)
+
+	// caller without balance
+	res = httpPost(t, ts.URL+"/transactions/estimate", transactions.EstimateRequest{
+		Clauses: transactions.Clauses{clause},
+	})
+	result = transactions.EstimateResult{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, result.Reverted)
+	assert.NotEqual(t, "", result.VMError)
+}
+
+func txStatus(t *testing.T) {
+	var status transactions.TxStatus
+	res := httpGet(t, ts.URL+"/transactions/"+transaction.ID().String()+"/status")
+	if err := json.Unmarshal(res, &status); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, transactions.TxStatusPacked, status.Status)
+	assert.Equal(t, uint32(1), status.Meta.BlockNumber)
+
+	// not executable until block 2
+	pending := new(tx.Builder).
+		BlockRef(tx.NewBlockRef(2)).
+		ChainTag(repo.ChainTag()).
+		Expiration(10).
+		Gas(21000).
+		Build()
+	sig, err := crypto.Sign(pending.SigningHash().Bytes(), genesis.DevAccounts()[1].PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pending = pending.WithSignature(sig)
+	rlpTx, err := rlp.EncodeToBytes(pending)
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpPost(t, ts.URL+"/transactions", transactions.RawTx{Raw: hexutil.Encode(rlpTx)})
+
+	status = transactions.TxStatus{}
+	res = httpGet(t, ts.URL+"/transactions/"+pending.ID().String()+"/status")
+	if err := json.Unmarshal(res, &status); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, transactions.TxStatusPending, status.Status)
+	assert.Nil(t, status.Meta)
+
+	status = transactions.TxStatus{}
+	res = httpGet(t, ts.URL+"/transactions/"+thor.Bytes32{}.String()+"/status")
+	if err := json.Unmarshal(res, &status); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, transactions.TxStatusUnknown, status.Status)
+}
+
 func httpPost(t *testing.T, url string, obj interface{}) []byte {
 	data, err := json.Marshal(obj)
 	if err != nil {
@@ -166,7 +263,7 @@ func initTransactionServer(t *testing.T) {
 		t.Fatal(err)
 	}
 	router := mux.NewRouter()
-	transactions.New(repo, txpool.New(repo, stater, txpool.Options{Limit: 10000, LimitPerAccount: 16, MaxLifetime: 10 * time.Minute})).Mount(router, "/transactions")
+	transactions.New(repo, stater, txpool.New(repo, stater, txpool.Options{Limit: 10000, LimitPerAccount: 16, MaxLifetime: 10 * time.Minute}), finality.New(repo, stater), 10000000, thor.NoFork).Mount(router, "/transactions")
 	ts = httptest.NewServer(router)
 
 }
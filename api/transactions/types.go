@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/abi"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
@@ -83,8 +84,8 @@ type rawTransaction struct {
 	Meta *TxMeta `json:"meta"`
 }
 
-//convertTransaction convert a raw transaction into a json format transaction
-func convertTransaction(tx *tx.Transaction, header *block.Header) *Transaction {
+//ConvertTransaction convert a raw transaction into a json format transaction
+func ConvertTransaction(tx *tx.Transaction, header *block.Header) *Transaction {
 	//tx origin
 	origin, _ := tx.Origin()
 	delegator, _ := tx.Delegator()
@@ -131,17 +132,20 @@ type ReceiptMeta struct {
 	BlockTimestamp uint64       `json:"blockTimestamp"`
 	TxID           thor.Bytes32 `json:"txID"`
 	TxOrigin       thor.Address `json:"txOrigin"`
+	Confirmations  uint32       `json:"confirmations"` // count of trunk blocks on top of the block, 0 if not in trunk
+	Finalized      bool         `json:"finalized"`
 }
 
 //Receipt for json marshal
 type Receipt struct {
-	GasUsed  uint64                `json:"gasUsed"`
-	GasPayer thor.Address          `json:"gasPayer"`
-	Paid     *math.HexOrDecimal256 `json:"paid"`
-	Reward   *math.HexOrDecimal256 `json:"reward"`
-	Reverted bool                  `json:"reverted"`
-	Meta     ReceiptMeta           `json:"meta"`
-	Outputs  []*Output             `json:"outputs"`
+	GasUsed      uint64                `json:"gasUsed"`
+	GasPayer     thor.Address          `json:"gasPayer"`
+	Paid         *math.HexOrDecimal256 `json:"paid"`
+	Reward       *math.HexOrDecimal256 `json:"reward"`
+	Reverted     bool                  `json:"reverted"`
+	RevertReason string                `json:"revertReason"`
+	Meta         ReceiptMeta           `json:"meta"`
+	Outputs      []*Output             `json:"outputs"`
 }
 
 // Output output of clause execution.
@@ -185,8 +189,14 @@ func convertReceipt(txReceipt *tx.Receipt, header *block.Header, tx *tx.Transact
 			header.Timestamp(),
 			tx.ID(),
 			origin,
+			0,
+			false,
 		},
 	}
+	if txReceipt.Reverted {
+		// outputs are dropped when reverted, the reason is decoded from the revert data of the failed clause
+		receipt.RevertReason, _ = abi.UnpackRevert(txReceipt.RevertData)
+	}
 	receipt.Outputs = make([]*Output, len(txReceipt.Outputs))
 	for i, output := range txReceipt.Outputs {
 		clause := tx.Clauses()[i]
@@ -223,3 +233,44 @@ func convertReceipt(txReceipt *tx.Receipt, header *block.Header, tx *tx.Transact
 	}
 	return receipt, nil
 }
+
+// EstimateRequest represents the body of gas estimation request.
+type EstimateRequest struct {
+	Clauses      Clauses       `json:"clauses"`
+	Caller       *thor.Address `json:"caller"`
+	GasPayer     *thor.Address `json:"gasPayer"`
+	Delegator    *thor.Address `json:"delegator"` // the VIP-191 delegator, who pays the gas
+	GasPriceCoef uint8         `json:"gasPriceCoef"`
+	Gas          uint64        `json:"gas"`
+}
+
+// EstimateResult represents the result of gas estimation.
+// If reverted, the gas values are of the execution with max allowed gas.
+type EstimateResult struct {
+	IntrinsicGas uint64                `json:"intrinsicGas"`
+	ExecutionGas uint64                `json:"executionGas"`
+	TotalGas     uint64                `json:"totalGas"`
+	VTHOCost     *math.HexOrDecimal256 `json:"vthoCost"`
+	Reverted     bool                  `json:"reverted"`
+	VMError      string                `json:"vmError"`
+	RevertReason string                `json:"revertReason"`
+}
+
+// statuses of tx lifecycle.
+const (
+	TxStatusUnknown    = "unknown"
+	TxStatusPending    = "pending"
+	TxStatusExecutable = "executable"
+	TxStatusPacked     = "packed"
+	TxStatusReverted   = "reverted"
+	TxStatusDropped    = "dropped"
+)
+
+// TxStatus lifecycle status of tx.
+// Meta is set if packed or reverted, and Reason and DroppedAt are set if dropped.
+type TxStatus struct {
+	Status    string  `json:"status"`
+	Meta      *TxMeta `json:"meta"`
+	Reason    string  `json:"reason,omitempty"`
+	DroppedAt uint64  `json:"droppedAt,omitempty"`
+}
@@ -29,19 +29,30 @@ func New(repo *chain.Repository, db *logdb.LogDB) *Transfers {
 	}
 }
 
-//Filter query logs with option
-func (t *Transfers) filter(ctx context.Context, filter *TransferFilter) ([]*FilteredTransfer, error) {
+func (t *Transfers) convertFilter(filter *TransferFilter) (*logdb.TransferFilter, error) {
 	rng, err := events.ConvertRange(t.repo.NewBestChain(), filter.Range)
 	if err != nil {
 		return nil, err
 	}
-
-	transfers, err := t.db.FilterTransfers(ctx, &logdb.TransferFilter{
+	opts, err := events.ConvertOptions(filter.Options)
+	if err != nil {
+		return nil, err
+	}
+	return &logdb.TransferFilter{
 		CriteriaSet: filter.CriteriaSet,
 		Range:       rng,
-		Options:     filter.Options,
+		Options:     opts,
 		Order:       filter.Order,
-	})
+	}, nil
+}
+
+//Filter query logs with option
+func (t *Transfers) filter(ctx context.Context, filter *TransferFilter) ([]*FilteredTransfer, error) {
+	f, err := t.convertFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	transfers, err := t.db.FilterTransfers(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -52,11 +63,26 @@ func (t *Transfers) filter(ctx context.Context, filter *TransferFilter) ([]*Filt
 	return tLogs, nil
 }
 
+func (t *Transfers) count(ctx context.Context, filter *TransferFilter) (uint64, error) {
+	f, err := t.convertFilter(filter)
+	if err != nil {
+		return 0, err
+	}
+	return t.db.CountTransfers(ctx, f)
+}
+
 func (t *Transfers) handleFilterTransferLogs(w http.ResponseWriter, req *http.Request) error {
 	var filter TransferFilter
 	if err := utils.ParseJSON(req.Body, &filter); err != nil {
 		return utils.BadRequest(errors.WithMessage(err, "body"))
 	}
+	if req.URL.Query().Get("count") == "true" {
+		count, err := t.count(req.Context(), &filter)
+		if err != nil {
+			return err
+		}
+		return utils.WriteJSON(w, map[string]uint64{"count": count})
+	}
 	tLogs, err := t.filter(req.Context(), &filter)
 	if err != nil {
 		return err
@@ -19,6 +19,7 @@ type LogMeta struct {
 	TxID           thor.Bytes32 `json:"txID"`
 	TxOrigin       thor.Address `json:"txOrigin"`
 	ClauseIndex    uint32       `json:"clauseIndex"`
+	Cursor         string       `json:"cursor"`
 }
 
 type FilteredTransfer struct {
@@ -41,6 +42,7 @@ func convertTransfer(transfer *logdb.Transfer) *FilteredTransfer {
 			TxID:           transfer.TxID,
 			TxOrigin:       transfer.TxOrigin,
 			ClauseIndex:    transfer.ClauseIndex,
+			Cursor:         (&logdb.Cursor{BlockNumber: transfer.BlockNumber, Index: transfer.Index}).String(),
 		},
 	}
 }
@@ -48,6 +50,6 @@ func convertTransfer(transfer *logdb.Transfer) *FilteredTransfer {
 type TransferFilter struct {
 	CriteriaSet []*logdb.TransferCriteria
 	Range       *events.Range
-	Options     *logdb.Options
+	Options     *events.Options
 	Order       logdb.Order //default asc
 }
@@ -0,0 +1,86 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package utils
+
+import (
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/finality"
+	"github.com/vechain/thor/thor"
+)
+
+// FinalizedRevision is the parsed revision of the latest finalized block.
+type FinalizedRevision struct{}
+
+// ParseRevision parses the revision string of a block.
+// It returns nil for the best block, FinalizedRevision, thor.Bytes32 for a block ID or uint32 for a block number.
+func ParseRevision(revision string) (interface{}, error) {
+	if revision == "" || revision == "best" {
+		return nil, nil
+	}
+	if revision == "finalized" {
+		return FinalizedRevision{}, nil
+	}
+	if len(revision) == 66 || len(revision) == 64 {
+		blockID, err := thor.ParseBytes32(revision)
+		if err != nil {
+			return nil, err
+		}
+		return blockID, nil
+	}
+	n, err := strconv.ParseUint(revision, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	if n > math.MaxUint32 {
+		return nil, errors.New("block number out of max uint32")
+	}
+	return uint32(n), err
+}
+
+// GetSummaryByRevision returns the summary of the block the parsed revision refers to.
+func GetSummaryByRevision(revision interface{}, repo *chain.Repository, fin *finality.Finality) (*chain.BlockSummary, error) {
+	var id thor.Bytes32
+	switch revision := revision.(type) {
+	case thor.Bytes32:
+		id = revision
+	case uint32:
+		var err error
+		if id, err = repo.NewBestChain().GetBlockID(revision); err != nil {
+			return nil, err
+		}
+	case FinalizedRevision:
+		header, err := fin.Finalized()
+		if err != nil {
+			return nil, err
+		}
+		id = header.ID()
+	default:
+		id = repo.BestBlock().Header().ID()
+	}
+	return repo.GetBlockSummary(id)
+}
+
+// GetHeaderByRevision parses the revision string and returns the block header it refers to.
+// Malformed revisions and unknown blocks are reported as bad requests.
+func GetHeaderByRevision(revision string, repo *chain.Repository, fin *finality.Finality) (*block.Header, error) {
+	rev, err := ParseRevision(revision)
+	if err != nil {
+		return nil, BadRequest(errors.WithMessage(err, "revision"))
+	}
+	summary, err := GetSummaryByRevision(rev, repo, fin)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, BadRequest(errors.WithMessage(err, "revision"))
+		}
+		return nil, err
+	}
+	return summary.Header, nil
+}
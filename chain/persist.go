@@ -16,8 +16,9 @@ import (
 )
 
 const (
-	txInfix      = byte(0)
-	receiptInfix = byte(1)
+	txInfix         = byte(0)
+	receiptInfix    = byte(1)
+	revertDataInfix = byte(2)
 )
 
 // BlockSummary presents block summary.
@@ -93,3 +94,15 @@ func loadReceipt(r kv.Getter, key txKey) (*tx.Receipt, error) {
 	}
 	return &receipt, nil
 }
+
+// revert data is excluded from the RLP encoding of receipt, so it's saved separately.
+// the key is the receipt key with infix replaced.
+func saveRevertData(w kv.Putter, key txKey, data []byte) error {
+	key[32] = revertDataInfix
+	return w.Put(key[:], data)
+}
+
+func loadRevertData(r kv.Getter, key txKey) ([]byte, error) {
+	key[32] = revertDataInfix
+	return r.Get(key[:])
+}
@@ -166,6 +166,11 @@ func (r *Repository) saveBlock(block *block.Block, receipts tx.Receipts, indexRo
 				if err := saveReceipt(putter, key, receipt); err != nil {
 					return err
 				}
+				if receipt.Reverted && len(receipt.RevertData) > 0 {
+					if err := saveRevertData(putter, key, receipt.RevertData); err != nil {
+						return err
+					}
+				}
 				r.caches.receipts.Add(key, receipt)
 			}
 		}
@@ -197,6 +202,12 @@ func (r *Repository) AddBlock(newBlock *block.Block, receipts tx.Receipts) error
 	return nil
 }
 
+// RestoreBlock adds a block with the root of its index trie, which is restored beforehand.
+// Unlike AddBlock, the parent of the block is not required. It's used to bootstrap the repository from a snapshot.
+func (r *Repository) RestoreBlock(newBlock *block.Block, receipts tx.Receipts, indexRoot thor.Bytes32) error {
+	return r.saveBlock(newBlock, receipts, indexRoot)
+}
+
 // GetBlockSummary get block summary by block id.
 func (r *Repository) GetBlockSummary(id thor.Bytes32) (summary *BlockSummary, err error) {
 	var cached interface{}
@@ -255,7 +266,21 @@ func (r *Repository) GetBlock(id thor.Bytes32) (*block.Block, error) {
 
 func (r *Repository) getReceipt(key txKey) (*tx.Receipt, error) {
 	cached, err := r.caches.receipts.GetOrLoad(key, func() (interface{}, error) {
-		return loadReceipt(r.data, key)
+		receipt, err := loadReceipt(r.data, key)
+		if err != nil {
+			return nil, err
+		}
+		if receipt.Reverted {
+			data, err := loadRevertData(r.data, key)
+			if err != nil {
+				if !r.IsNotFound(err) {
+					return nil, err
+				}
+			} else {
+				receipt.RevertData = data
+			}
+		}
+		return receipt, nil
 	})
 	if err != nil {
 		return nil, err
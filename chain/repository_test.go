@@ -90,3 +90,27 @@ func TestRepository(t *testing.T) {
 		assert.Equal(t, tx.Receipts{receipt1}.RootHash(), gotReceipts.RootHash())
 	}
 }
+
+func TestRevertData(t *testing.T) {
+	db := muxdb.NewMem()
+	g := genesis.NewDevnet()
+	b0, _, _, _ := g.Build(state.NewStater(db))
+
+	repo1, _ := NewRepository(db, b0)
+
+	tx1 := new(tx.Builder).Nonce(1).Build()
+	tx2 := new(tx.Builder).Nonce(2).Build()
+	receipt1 := &tx.Receipt{Reverted: true, RevertData: []byte{1, 2, 3}}
+	receipt2 := &tx.Receipt{}
+
+	b1 := newBlock(repo1.GenesisBlock(), 10, tx1, tx2)
+	assert.Nil(t, repo1.AddBlock(b1, tx.Receipts{receipt1, receipt2}))
+
+	// a fresh repository to bypass the cache
+	repo2, _ := NewRepository(db, b0)
+	receipts, err := repo2.GetBlockReceipts(b1.Header().ID())
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{1, 2, 3}, receipts[0].RevertData)
+	assert.Nil(t, receipts[1].RevertData)
+	assert.Equal(t, tx.Receipts{receipt1, receipt2}.RootHash(), receipts.RootHash())
+}
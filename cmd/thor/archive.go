@@ -0,0 +1,258 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/mclock"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/consensus"
+	"github.com/vechain/thor/logdb"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"gopkg.in/cheggaaa/pb.v1"
+	cli "gopkg.in/urfave/cli.v1"
+)
+
+// magic bytes of gzip stream
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// offlineInstance the databases and chain repository of an instance, opened by commands operating on chain data
+// while the node is not running.
+type offlineInstance struct {
+	mainDB     *muxdb.MuxDB
+	logDB      *logdb.LogDB
+	repo       *chain.Repository
+	forkConfig thor.ForkConfig
+}
+
+func openOfflineInstance(ctx *cli.Context) (*offlineInstance, error) {
+	gene, forkConfig, err := selectGenesis(ctx)
+	if err != nil {
+		return nil, err
+	}
+	instanceDir, err := makeInstanceDir(ctx, gene)
+	if err != nil {
+		return nil, err
+	}
+	mainDB, err := openMainDB(ctx, instanceDir)
+	if err != nil {
+		return nil, err
+	}
+	logDB, err := openLogDB(ctx, instanceDir)
+	if err != nil {
+		mainDB.Close()
+		return nil, err
+	}
+	inst := &offlineInstance{
+		mainDB:     mainDB,
+		logDB:      logDB,
+		forkConfig: forkConfig,
+	}
+	if inst.repo, err = initChainRepository(gene, mainDB, logDB); err != nil {
+		inst.Close()
+		return nil, err
+	}
+	return inst, nil
+}
+
+func (i *offlineInstance) Close() {
+	log.Info("closing log database...")
+	i.logDB.Close()
+	log.Info("closing main database...")
+	i.mainDB.Close()
+}
+
+func exportAction(ctx *cli.Context) error {
+	exitSignal := handleExitSignal()
+	defer func() { log.Info("exited") }()
+
+	initLogger(ctx)
+	out := ctx.String(exportOutFlag.Name)
+	if out == "" {
+		return fmt.Errorf("flag %s not specified", exportOutFlag.Name)
+	}
+
+	inst, err := openOfflineInstance(ctx)
+	if err != nil {
+		return err
+	}
+	defer inst.Close()
+
+	repo := inst.repo
+	bestChain := repo.NewBestChain()
+	from := uint32(ctx.Uint64(exportFromFlag.Name))
+	to := repo.BestBlock().Header().Number()
+	if ctx.IsSet(exportToFlag.Name) {
+		if v := ctx.Uint64(exportToFlag.Name); v < uint64(to) {
+			to = uint32(v)
+		}
+	}
+	if from > to {
+		return fmt.Errorf("invalid range [%v, %v], best block is %v", from, to, repo.BestBlock().Header().Number())
+	}
+
+	file, err := os.Create(out)
+	if err != nil {
+		return errors.Wrap(err, "create archive file")
+	}
+	defer file.Close()
+
+	bw := bufio.NewWriter(file)
+	var w io.Writer = bw
+	var gw *gzip.Writer
+	if ctx.Bool(exportGzipFlag.Name) {
+		gw = gzip.NewWriter(bw)
+		w = gw
+	}
+
+	fmt.Printf(">> Exporting blocks [%v, %v] to %v <<\n", from, to, out)
+	pb := pb.New64(int64(to-from) + 1).
+		SetMaxWidth(90).
+		Start()
+	defer func() { pb.NotPrint = true }()
+
+	for i := from; i <= to; i++ {
+		b, err := bestChain.GetBlock(i)
+		if err != nil {
+			return errors.Wrap(err, "get block")
+		}
+		if err := rlp.Encode(w, b); err != nil {
+			return errors.Wrap(err, "write block")
+		}
+		select {
+		case <-exitSignal.Done():
+			return exitSignal.Err()
+		default:
+		}
+		pb.Add64(1)
+	}
+	if gw != nil {
+		if err := gw.Close(); err != nil {
+			return err
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+	pb.Finish()
+	return file.Close()
+}
+
+func importAction(ctx *cli.Context) error {
+	exitSignal := handleExitSignal()
+	defer func() { log.Info("exited") }()
+
+	initLogger(ctx)
+	path := ctx.Args().First()
+	if path == "" {
+		return errors.New("archive file not specified")
+	}
+
+	inst, err := openOfflineInstance(ctx)
+	if err != nil {
+		return err
+	}
+	defer inst.Close()
+
+	file, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "open archive file")
+	}
+	defer file.Close()
+
+	br := bufio.NewReader(file)
+	var r io.Reader = br
+	// gzip compressed archive is detected by magic bytes
+	if magic, err := br.Peek(len(gzipMagic)); err == nil && bytes.Equal(magic, gzipMagic) {
+		gr, err := gzip.NewReader(br)
+		if err != nil {
+			return errors.Wrap(err, "open gzip stream")
+		}
+		defer gr.Close()
+		r = gr
+	}
+
+	fmt.Printf(">> Importing blocks from %v <<\n", path)
+	return importBlocks(
+		exitSignal,
+		inst.repo,
+		consensus.New(inst.repo, state.NewStater(inst.mainDB), inst.forkConfig),
+		rlp.NewStream(r, 0))
+}
+
+// importBlocks processes and saves blocks decoded from the stream.
+// Known blocks are skipped, so that an interrupted import can be resumed by importing the same archive again.
+// Logs are not written here, but synced when the node starts.
+func importBlocks(ctx context.Context, repo *chain.Repository, cons *consensus.Consensus, stream *rlp.Stream) error {
+	var (
+		imported, skipped int
+		startTime         = mclock.Now()
+		blk               *block.Block
+	)
+	report := func() {
+		log.Info("imported blocks", "count", imported, "skipped", skipped, "number", blk.Header().Number(), "id", blk.Header().ID())
+		imported, skipped = 0, 0
+		startTime = mclock.Now()
+	}
+
+	for {
+		var b block.Block
+		if err := stream.Decode(&b); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return errors.Wrap(err, "decode block")
+		}
+		blk = &b
+
+		stage, receipts, err := cons.Process(blk, uint64(time.Now().Unix()))
+		if err != nil {
+			if !consensus.IsKnownBlock(err) {
+				return errors.WithMessage(err, fmt.Sprintf("process block %v", blk.Header().Number()))
+			}
+			skipped++
+		} else {
+			if _, err := stage.Commit(); err != nil {
+				return errors.Wrap(err, "commit state")
+			}
+			if err := repo.AddBlock(blk, receipts); err != nil {
+				return errors.Wrap(err, "add block")
+			}
+			if blk.Header().BetterThan(repo.BestBlock().Header()) {
+				if err := repo.SetBestBlockID(blk.Header().ID()); err != nil {
+					return err
+				}
+			}
+			imported++
+		}
+
+		if mclock.Now()-startTime > mclock.AbsTime(time.Second*2) {
+			report()
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+	}
+	if blk != nil && imported+skipped > 0 {
+		report()
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/testchain"
+	"github.com/vechain/thor/thor"
+	cli "gopkg.in/urfave/cli.v1"
+)
+
+// newContext creates the cli context of a command with the given args.
+func newContext(t *testing.T, flags []cli.Flag, args ...string) *cli.Context {
+	set := flag.NewFlagSet("test", flag.ContinueOnError)
+	for _, f := range flags {
+		f.Apply(set)
+	}
+	if err := set.Parse(args); err != nil {
+		t.Fatal(err)
+	}
+	return cli.NewContext(nil, set, nil)
+}
+
+func TestArchive(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thor-archive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	genesisFile := filepath.Join(dir, "genesis.json")
+	data, err := testchain.GenesisJSON(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(genesisFile, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	instanceFlags := []cli.Flag{networkFlag, dataDirFlag, cacheFlag, disablePrunerFlag, verbosityFlag}
+	exportFlags := []cli.Flag{networkFlag, dataDirFlag, cacheFlag, disablePrunerFlag, verbosityFlag,
+		exportFromFlag, exportToFlag, exportOutFlag, exportGzipFlag}
+	instanceArgs := func(dataDir string) []string {
+		return []string{"--network", genesisFile, "--data-dir", dataDir, "--cache", "128"}
+	}
+	bestBlock := func(dataDir string) *block.Header {
+		inst, err := openOfflineInstance(newContext(t, instanceFlags, instanceArgs(dataDir)...))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer inst.Close()
+		return inst.repo.BestBlock().Header()
+	}
+
+	// produce blocks in the source instance
+	srcDir := filepath.Join(dir, "src")
+	inst, err := openOfflineInstance(newContext(t, instanceFlags, instanceArgs(srcDir)...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := testchain.New(inst.mainDB, inst.repo, genesis.DevAccounts()[:1])
+	best := inst.repo.GenesisBlock().Header()
+	for i := 0; i < 5; i++ {
+		blk, err := c.NewBlock(best, best.Timestamp()+thor.BlockInterval)
+		if err != nil {
+			t.Fatal(err)
+		}
+		best = blk.Header()
+	}
+	if err := inst.repo.SetBestBlockID(best.ID()); err != nil {
+		t.Fatal(err)
+	}
+	inst.Close()
+
+	export := func(out string, args ...string) error {
+		args = append(append(instanceArgs(srcDir), "--out", out), args...)
+		return exportAction(newContext(t, exportFlags, args...))
+	}
+	partial := filepath.Join(dir, "partial.rlp")
+	full := filepath.Join(dir, "full.rlp.gz")
+	assert.Nil(t, export(partial, "--to", "2"))
+	assert.Nil(t, export(full, "--gzip"))
+	assert.NotNil(t, export(partial, "--from", "6"), "invalid range")
+
+	raw, err := ioutil.ReadFile(full)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, bytes.HasPrefix(raw, gzipMagic), "gzip compressed")
+
+	importArchive := func(dataDir, path string) error {
+		return importAction(newContext(t, instanceFlags, append(instanceArgs(dataDir), path)...))
+	}
+	dstDir := filepath.Join(dir, "dst")
+	assert.Nil(t, importArchive(dstDir, partial))
+	assert.Equal(t, uint32(2), bestBlock(dstDir).Number())
+
+	// resumed with known blocks skipped, and gzip detected
+	assert.Nil(t, importArchive(dstDir, full))
+	assert.Equal(t, best.ID(), bestBlock(dstDir).ID())
+
+	// imported again
+	assert.Nil(t, importArchive(dstDir, full))
+	assert.Equal(t, best.ID(), bestBlock(dstDir).ID())
+
+	assert.NotNil(t, importArchive(dstDir, filepath.Join(dir, "absent")))
+}
@@ -7,6 +7,7 @@ package main
 
 import (
 	"github.com/inconshreveable/log15"
+	"github.com/vechain/thor/thor"
 	cli "gopkg.in/urfave/cli.v1"
 )
 
@@ -53,7 +54,17 @@ var (
 	apiBacktraceLimitFlag = cli.IntFlag{
 		Name:  "api-backtrace-limit",
 		Value: 1000,
-		Usage: "limit the distance between 'position' and best block for subscriptions APIs",
+		Usage: "limit the distance between 'position' and best block for subscriptions APIs, and entries per page of account history API",
+	}
+	apiAdminAddrFlag = cli.StringFlag{
+		Name:  "api-admin-addr",
+		Value: "",
+		Usage: "admin API service listening address, which should not be exposed publicly (disabled if not set)",
+	}
+	metricsAddrFlag = cli.StringFlag{
+		Name:  "metrics-addr",
+		Value: "",
+		Usage: "prometheus metrics service listening address (disabled if not set)",
 	}
 	verbosityFlag = cli.IntFlag{
 		Name:  "verbosity",
@@ -138,4 +149,64 @@ var (
 		Value: 16,
 		Usage: "set tx limit per account in pool",
 	}
+	txPoolPriceBumpFlag = cli.IntFlag{
+		Name:  "txpool-price-bump",
+		Value: 10,
+		Usage: "set minimum price bump in percentage to replace a pending tx, the replaced tx is still valid on chain",
+	}
+	txPoolJournalFlag = cli.BoolFlag{
+		Name:  "txpool-journal",
+		Usage: "persist txs in pool across restarts",
+	}
+	txPoolSenderRateLimitFlag = cli.IntFlag{
+		Name:  "txpool-sender-rate-limit",
+		Usage: "set max txs per minute from an origin or delegator, 0 means unlimited",
+	}
+	txPoolLocalLifetimeFlag = cli.IntFlag{
+		Name:  "txpool-local-lifetime",
+		Usage: "set lifetime in seconds of locally submitted txs in pool, 0 means unlimited",
+	}
+	txPoolRebroadcastFlag = cli.IntFlag{
+		Name:  "txpool-rebroadcast",
+		Value: 60,
+		Usage: "set interval in seconds to rebroadcast executable local txs to peers, 0 to disable",
+	}
+	packingStrategyFlag = cli.StringFlag{
+		Name:  "packing-strategy",
+		Value: "gasprice",
+		Usage: "strategy to choose txs when packing blocks (gasprice|fairshare|fifo|knapsack)",
+	}
+	txPoolPolicyFlag = cli.StringFlag{
+		Name:  "txpool-policy",
+		Usage: "path to tx admission policy file (JSON or YAML)",
+	}
+	exportFromFlag = cli.Uint64Flag{
+		Name:  "from",
+		Usage: "number of the first block to export",
+	}
+	exportToFlag = cli.Uint64Flag{
+		Name:  "to",
+		Usage: "number of the last block to export, defaults to the best block",
+	}
+	exportOutFlag = cli.StringFlag{
+		Name:  "out",
+		Usage: "path of the archive file to write",
+	}
+	exportGzipFlag = cli.BoolFlag{
+		Name:  "gzip",
+		Usage: "compress the archive with gzip",
+	}
+	snapshotBlockFlag = cli.Uint64Flag{
+		Name:  "block",
+		Usage: "number of the block whose state is taken, defaults to the best block",
+	}
+	snapshotBlockIDFlag = cli.StringFlag{
+		Name:  "block-id",
+		Usage: "expected ID of the snapshot block, obtained from a trusted source",
+	}
+	snapshotBlocksFlag = cli.Uint64Flag{
+		Name:  "blocks",
+		Value: thor.MaxStateHistory,
+		Usage: "count of recent blocks included, which are accessible in EVM",
+	}
 )
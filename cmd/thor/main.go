@@ -24,8 +24,11 @@ import (
 	"github.com/vechain/thor/cmd/thor/pruner"
 	"github.com/vechain/thor/cmd/thor/solo"
 	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/liveness"
 	"github.com/vechain/thor/logdb"
 	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/packer"
+	"github.com/vechain/thor/reorg"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/txpool"
@@ -42,6 +45,7 @@ var (
 		Limit:           10000,
 		LimitPerAccount: 16,
 		MaxLifetime:     20 * time.Minute,
+		PriceBump:       10,
 	}
 )
 
@@ -71,6 +75,8 @@ func main() {
 			apiTimeoutFlag,
 			apiCallGasLimitFlag,
 			apiBacktraceLimitFlag,
+			apiAdminAddrFlag,
+			metricsAddrFlag,
 			verbosityFlag,
 			maxPeersFlag,
 			p2pPortFlag,
@@ -80,6 +86,13 @@ func main() {
 			pprofFlag,
 			verifyLogsFlag,
 			disablePrunerFlag,
+			txPoolPriceBumpFlag,
+			txPoolJournalFlag,
+			txPoolPolicyFlag,
+			txPoolSenderRateLimitFlag,
+			txPoolLocalLifetimeFlag,
+			txPoolRebroadcastFlag,
+			packingStrategyFlag,
 		},
 		Action: defaultAction,
 		Commands: []cli.Command{
@@ -94,6 +107,8 @@ func main() {
 					apiTimeoutFlag,
 					apiCallGasLimitFlag,
 					apiBacktraceLimitFlag,
+					apiAdminAddrFlag,
+					metricsAddrFlag,
 					onDemandFlag,
 					persistFlag,
 					gasLimitFlag,
@@ -103,6 +118,12 @@ func main() {
 					skipLogsFlag,
 					txPoolLimitFlag,
 					txPoolLimitPerAccountFlag,
+					txPoolPriceBumpFlag,
+					txPoolJournalFlag,
+					txPoolPolicyFlag,
+					txPoolSenderRateLimitFlag,
+					txPoolLocalLifetimeFlag,
+					packingStrategyFlag,
 					disablePrunerFlag,
 				},
 				Action: soloAction,
@@ -117,6 +138,72 @@ func main() {
 				},
 				Action: masterKeyAction,
 			},
+			{
+				Name:  "export",
+				Usage: "export trunk blocks into an archive file of RLP-encoded blocks",
+				Flags: []cli.Flag{
+					networkFlag,
+					dataDirFlag,
+					cacheFlag,
+					disablePrunerFlag,
+					verbosityFlag,
+					exportFromFlag,
+					exportToFlag,
+					exportOutFlag,
+					exportGzipFlag,
+				},
+				Action: exportAction,
+			},
+			{
+				Name:      "import",
+				Usage:     "import blocks from an archive file, which can be gzip compressed",
+				ArgsUsage: "<archive file>",
+				Flags: []cli.Flag{
+					networkFlag,
+					dataDirFlag,
+					cacheFlag,
+					disablePrunerFlag,
+					verbosityFlag,
+				},
+				Action: importAction,
+			},
+			{
+				Name:  "snapshot",
+				Usage: "state snapshot management",
+				Subcommands: []cli.Command{
+					{
+						Name:  "export",
+						Usage: "export the state of a block, with recent blocks, into a snapshot file",
+						Flags: []cli.Flag{
+							networkFlag,
+							dataDirFlag,
+							cacheFlag,
+							disablePrunerFlag,
+							verbosityFlag,
+							snapshotBlockFlag,
+							snapshotBlocksFlag,
+							exportOutFlag,
+						},
+						Action: snapshotExportAction,
+					},
+					{
+						Name:      "import",
+						Usage:     "import a snapshot file into an empty instance, which then syncs from the snapshot block",
+						ArgsUsage: "<snapshot file>",
+						Description: "The state and recent blocks are verified against the expected block ID. " +
+							"The index of older blocks and txs is not verifiable, so the snapshot file must be obtained from a trusted source.",
+						Flags: []cli.Flag{
+							networkFlag,
+							dataDirFlag,
+							cacheFlag,
+							disablePrunerFlag,
+							verbosityFlag,
+							snapshotBlockIDFlag,
+						},
+						Action: snapshotImportAction,
+					},
+				},
+			},
 		},
 	}
 
@@ -136,6 +223,10 @@ func defaultAction(ctx *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	strategy, err := packer.NewStrategy(ctx.String(packingStrategyFlag.Name))
+	if err != nil {
+		return err
+	}
 	instanceDir, err := makeInstanceDir(ctx, gene)
 	if err != nil {
 		return err
@@ -174,6 +265,15 @@ func defaultAction(ctx *cli.Context) error {
 	}
 
 	txpoolOpt := defaultTxPoolOptions
+	txpoolOpt.PriceBump = ctx.Int(txPoolPriceBumpFlag.Name)
+	txpoolOpt.SenderRateLimit = ctx.Int(txPoolSenderRateLimitFlag.Name)
+	txpoolOpt.LocalMaxLifetime = time.Duration(ctx.Int(txPoolLocalLifetimeFlag.Name)) * time.Second
+	if ctx.Bool(txPoolJournalFlag.Name) {
+		txpoolOpt.JournalPath = filepath.Join(instanceDir, "txpool.journal")
+	}
+	if txpoolOpt.Policy, err = loadTxPoolPolicy(ctx); err != nil {
+		return err
+	}
 	txPool := txpool.New(repo, state.NewStater(mainDB), txpoolOpt)
 	defer func() { log.Info("closing tx pool..."); txPool.Close() }()
 
@@ -181,12 +281,23 @@ func defaultAction(ctx *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	tracker, err := liveness.New(repo, state.NewStater(mainDB), mainDB)
+	if err != nil {
+		return err
+	}
+	reorgs, err := reorg.New(mainDB)
+	if err != nil {
+		return err
+	}
 	apiHandler, apiCloser := api.New(
 		repo,
 		state.NewStater(mainDB),
 		txPool,
 		logDB,
 		p2pcom.comm,
+		tracker,
+		reorgs,
+		strategy,
 		ctx.String(apiCorsFlag.Name),
 		uint32(ctx.Int(apiBacktraceLimitFlag.Name)),
 		uint64(ctx.Int(apiCallGasLimitFlag.Name)),
@@ -201,7 +312,20 @@ func defaultAction(ctx *cli.Context) error {
 	}
 	defer func() { log.Info("stopping API server..."); srvCloser() }()
 
-	printStartupMessage2(apiURL, p2pcom.enode)
+	adminURL, adminCloser, err := startAdminServer(ctx, api.NewAdmin(txPool))
+	if err != nil {
+		return err
+	}
+	defer func() { log.Info("stopping admin API server..."); adminCloser() }()
+
+	initMetrics(repo, txPool, mainDB, p2pcom.comm)
+	metricsURL, metricsCloser, err := startMetricsServer(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { log.Info("stopping metrics server..."); metricsCloser() }()
+
+	printStartupMessage2(apiURL, p2pcom.enode, adminURL, metricsURL)
 
 	if err := p2pcom.Start(); err != nil {
 		return err
@@ -223,7 +347,10 @@ func defaultAction(ctx *cli.Context) error {
 		p2pcom.comm,
 		uint64(ctx.Int(targetGasLimitFlag.Name)),
 		skipLogs,
-		forkConfig).Run(exitSignal)
+		forkConfig,
+		strategy,
+		tracker,
+		reorgs).Run(exitSignal)
 }
 
 func soloAction(ctx *cli.Context) error {
@@ -234,11 +361,14 @@ func soloAction(ctx *cli.Context) error {
 	gene := genesis.NewDevnet()
 	// Solo forks from the start
 	forkConfig := thor.ForkConfig{}
+	strategy, err := packer.NewStrategy(ctx.String(packingStrategyFlag.Name))
+	if err != nil {
+		return err
+	}
 
 	var mainDB *muxdb.MuxDB
 	var logDB *logdb.LogDB
 	var instanceDir string
-	var err error
 
 	if ctx.Bool(persistFlag.Name) {
 		if instanceDir, err = makeInstanceDir(ctx, gene); err != nil {
@@ -274,16 +404,33 @@ func soloAction(ctx *cli.Context) error {
 	txPoolOption := defaultTxPoolOptions
 	txPoolOption.Limit = ctx.Int(txPoolLimitFlag.Name)
 	txPoolOption.LimitPerAccount = ctx.Int(txPoolLimitPerAccountFlag.Name)
+	txPoolOption.PriceBump = ctx.Int(txPoolPriceBumpFlag.Name)
+	txPoolOption.SenderRateLimit = ctx.Int(txPoolSenderRateLimitFlag.Name)
+	txPoolOption.LocalMaxLifetime = time.Duration(ctx.Int(txPoolLocalLifetimeFlag.Name)) * time.Second
+	if ctx.Bool(txPoolJournalFlag.Name) && ctx.Bool(persistFlag.Name) {
+		txPoolOption.JournalPath = filepath.Join(instanceDir, "txpool.journal")
+	}
+	if txPoolOption.Policy, err = loadTxPoolPolicy(ctx); err != nil {
+		return err
+	}
 
 	txPool := txpool.New(repo, state.NewStater(mainDB), txPoolOption)
 	defer func() { log.Info("closing tx pool..."); txPool.Close() }()
 
+	reorgs, err := reorg.New(mainDB)
+	if err != nil {
+		return err
+	}
+
 	apiHandler, apiCloser := api.New(
 		repo,
 		state.NewStater(mainDB),
 		txPool,
 		logDB,
 		solo.Communicator{},
+		nil,
+		reorgs,
+		strategy,
 		ctx.String(apiCorsFlag.Name),
 		uint32(ctx.Int(apiBacktraceLimitFlag.Name)),
 		uint64(ctx.Int(apiCallGasLimitFlag.Name)),
@@ -298,7 +445,20 @@ func soloAction(ctx *cli.Context) error {
 	}
 	defer func() { log.Info("stopping API server..."); srvCloser() }()
 
-	printSoloStartupMessage(gene, repo, instanceDir, apiURL, forkConfig)
+	adminURL, adminCloser, err := startAdminServer(ctx, api.NewAdmin(txPool))
+	if err != nil {
+		return err
+	}
+	defer func() { log.Info("stopping admin API server..."); adminCloser() }()
+
+	initMetrics(repo, txPool, mainDB, nil)
+	metricsURL, metricsCloser, err := startMetricsServer(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { log.Info("stopping metrics server..."); metricsCloser() }()
+
+	printSoloStartupMessage(gene, repo, instanceDir, apiURL, adminURL, metricsURL, forkConfig)
 
 	if !ctx.Bool(disablePrunerFlag.Name) {
 		pruner := pruner.New(mainDB, repo)
@@ -312,7 +472,8 @@ func soloAction(ctx *cli.Context) error {
 		uint64(ctx.Int(gasLimitFlag.Name)),
 		ctx.Bool(onDemandFlag.Name),
 		skipLogs,
-		forkConfig).Run(exitSignal)
+		forkConfig,
+		strategy).Run(exitSignal)
 }
 
 func masterKeyAction(ctx *cli.Context) error {
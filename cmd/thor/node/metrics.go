@@ -0,0 +1,29 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package node
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/mclock"
+	"github.com/vechain/thor/metric"
+)
+
+var (
+	metricBlockExecTime = metric.NewHistogram(
+		"thor_block_execution_seconds",
+		"Time spent executing a block, including packed ones.",
+		nil)
+	metricBlockCommitTime = metric.NewHistogram(
+		"thor_block_commit_seconds",
+		"Time spent committing a block, including packed ones.",
+		nil)
+)
+
+func observeBlockProcessing(exec, commit mclock.AbsTime) {
+	metricBlockExecTime.Observe(time.Duration(exec).Seconds())
+	metricBlockCommitTime.Observe(time.Duration(commit).Seconds())
+}
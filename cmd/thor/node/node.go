@@ -27,8 +27,10 @@ import (
 	"github.com/vechain/thor/co"
 	"github.com/vechain/thor/comm"
 	"github.com/vechain/thor/consensus"
+	"github.com/vechain/thor/liveness"
 	"github.com/vechain/thor/logdb"
 	"github.com/vechain/thor/packer"
+	"github.com/vechain/thor/reorg"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
@@ -40,6 +42,7 @@ var log = log15.New("pkg", "node")
 type Node struct {
 	goes     co.Goes
 	packer   *packer.Packer
+	strategy packer.Strategy
 	cons     *consensus.Consensus
 	consLock sync.Mutex
 
@@ -54,6 +57,8 @@ type Node struct {
 	skipLogs       bool
 	logDBFailed    bool
 	bandwidth      bandwidth.Bandwidth
+	tracker        *liveness.Tracker
+	reorgs         *reorg.Journal
 }
 
 func New(
@@ -67,9 +72,13 @@ func New(
 	targetGasLimit uint64,
 	skipLogs bool,
 	forkConfig thor.ForkConfig,
+	strategy packer.Strategy,
+	tracker *liveness.Tracker,
+	reorgs *reorg.Journal,
 ) *Node {
 	return &Node{
 		packer:         packer.New(repo, stater, master.Address(), master.Beneficiary, forkConfig),
+		strategy:       strategy,
 		cons:           consensus.New(repo, stater, forkConfig),
 		master:         master,
 		repo:           repo,
@@ -79,6 +88,8 @@ func New(
 		comm:           comm,
 		targetGasLimit: targetGasLimit,
 		skipLogs:       skipLogs,
+		tracker:        tracker,
+		reorgs:         reorgs,
 	}
 }
 
@@ -276,6 +287,7 @@ func (n *Node) processBlock(blk *block.Block, stats *blockStats) (bool, error) {
 		return false, err
 	}
 	commitElapsed := mclock.Now() - startTime - execElapsed
+	observeBlockProcessing(execElapsed, commitElapsed)
 
 	if v, updated := n.bandwidth.Update(blk.Header(), time.Duration(execElapsed+commitElapsed)); updated {
 		log.Debug("bandwidth updated", "gps", v)
@@ -334,11 +346,30 @@ func (n *Node) commitBlock(stage *state.Stage, newBlock *block.Block, receipts t
 		if err := n.repo.SetBestBlockID(newBlock.Header().ID()); err != nil {
 			return nil, nil, err
 		}
+		n.trackLiveness(newBlock.Header())
 	}
 
 	return n.repo.NewChain(prevBest.Header().ID()), n.repo.NewBestChain(), nil
 }
 
+// trackLiveness tracks proposers of the new best block, and warns if the local master missed its slots.
+func (n *Node) trackLiveness(header *block.Header) {
+	missed, err := n.tracker.Track(header, uint64(time.Now().Unix()))
+	if err != nil {
+		log.Warn("failed to track proposers", "err", err)
+		return
+	}
+	count := 0
+	for _, addr := range missed {
+		if addr == n.master.Address() {
+			count++
+		}
+	}
+	if count > 0 {
+		log.Warn("master missed its slots", "count", count, "block", header.Number())
+	}
+}
+
 func (n *Node) writeLogs(diff []thor.Bytes32, newBlock *block.Block, newReceipts tx.Receipts) error {
 	// write full trunk blocks to prevent logs dropped
 	// in rare condition of long fork
@@ -377,6 +408,10 @@ side-chain:   %v  %v`,
 			n, sideIds[n-1]))
 	}
 
+	if err := n.recordReorg(prevTrunk, curTrunk, sideIds); err != nil {
+		log.Warn("failed to record reorg", "err", err)
+	}
+
 	for _, id := range sideIds {
 		b, err := n.repo.GetBlock(id)
 		if err != nil {
@@ -384,13 +419,28 @@ side-chain:   %v  %v`,
 			return
 		}
 		for _, tx := range b.Transactions() {
-			if err := n.txPool.Add(tx); err != nil {
+			if err := n.txPool.Restore(tx); err != nil {
 				log.Debug("failed to add tx to tx pool", "err", err, "id", tx.ID())
 			}
 		}
 	}
 }
 
+// recordReorg records the reorg into the journal, where sideIds are blocks orphaned from the previous trunk.
+func (n *Node) recordReorg(prevTrunk, curTrunk *chain.Chain, sideIds []thor.Bytes32) error {
+	summary, err := n.repo.GetBlockSummary(sideIds[0])
+	if err != nil {
+		return err
+	}
+	return n.reorgs.Record(&reorg.Reorg{
+		Timestamp:      uint64(time.Now().Unix()),
+		OldHead:        prevTrunk.HeadID(),
+		NewHead:        curTrunk.HeadID(),
+		CommonAncestor: summary.Header.ParentID(),
+		Orphaned:       sideIds,
+	})
+}
+
 func checkClockOffset() {
 	resp, err := ntp.Query("pool.ntp.org")
 	if err != nil {
@@ -15,7 +15,6 @@ import (
 	"github.com/pkg/errors"
 	"github.com/vechain/thor/packer"
 	"github.com/vechain/thor/thor"
-	"github.com/vechain/thor/tx"
 )
 
 func (n *Node) packerLoop(ctx context.Context) {
@@ -101,26 +100,19 @@ func (n *Node) packerLoop(ctx context.Context) {
 
 func (n *Node) pack(flow *packer.Flow) error {
 	txs := n.txPool.Executables()
-	var txsToRemove []*tx.Transaction
+	candidates := make([]*packer.Candidate, 0, len(txs))
+	for _, tx := range txs {
+		candidates = append(candidates, &packer.Candidate{Tx: tx, Arrival: n.txPool.TimeAdded(tx.ID())})
+	}
+
+	startTime := mclock.Now()
+	txsToRemove := n.strategy.Fill(flow, candidates)
 	defer func() {
 		for _, tx := range txsToRemove {
 			n.txPool.Remove(tx.Hash(), tx.ID())
 		}
 	}()
 
-	startTime := mclock.Now()
-	for _, tx := range txs {
-		if err := flow.Adopt(tx); err != nil {
-			if packer.IsGasLimitReached(err) {
-				break
-			}
-			if packer.IsTxNotAdoptableNow(err) {
-				continue
-			}
-			txsToRemove = append(txsToRemove, tx)
-		}
-	}
-
 	newBlock, stage, receipts, err := flow.Pack(n.master.PrivateKey)
 	if err != nil {
 		return err
@@ -132,6 +124,7 @@ func (n *Node) pack(flow *packer.Flow) error {
 		return errors.WithMessage(err, "commit block")
 	}
 	commitElapsed := mclock.Now() - startTime - execElapsed
+	observeBlockProcessing(execElapsed, commitElapsed)
 
 	n.processFork(prevTrunk, curTrunk)
 
@@ -0,0 +1,153 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/logdb"
+	"github.com/vechain/thor/snapshot"
+	"github.com/vechain/thor/thor"
+	cli "gopkg.in/urfave/cli.v1"
+)
+
+func snapshotExportAction(ctx *cli.Context) error {
+	exitSignal := handleExitSignal()
+	defer func() { log.Info("exited") }()
+
+	initLogger(ctx)
+	out := ctx.String(exportOutFlag.Name)
+	if out == "" {
+		return fmt.Errorf("flag %s not specified", exportOutFlag.Name)
+	}
+
+	inst, err := openOfflineInstance(ctx)
+	if err != nil {
+		return err
+	}
+	defer inst.Close()
+
+	header := inst.repo.BestBlock().Header()
+	if ctx.IsSet(snapshotBlockFlag.Name) {
+		if header, err = inst.repo.NewBestChain().GetBlockHeader(uint32(ctx.Uint64(snapshotBlockFlag.Name))); err != nil {
+			return errors.Wrap(err, "get snapshot block")
+		}
+	}
+
+	file, err := os.Create(out)
+	if err != nil {
+		return errors.Wrap(err, "create snapshot file")
+	}
+	defer file.Close()
+
+	bw := bufio.NewWriter(file)
+	gw := gzip.NewWriter(bw)
+
+	fmt.Printf(">> Exporting snapshot of block %v %v to %v <<\n", header.Number(), header.ID(), out)
+	if err := snapshot.Export(
+		exitSignal,
+		gw,
+		inst.mainDB,
+		inst.repo,
+		header.ID(),
+		uint32(ctx.Uint64(snapshotBlocksFlag.Name))); err != nil {
+		return err
+	}
+	if err := gw.Close(); err != nil {
+		return err
+	}
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+func snapshotImportAction(ctx *cli.Context) error {
+	exitSignal := handleExitSignal()
+	defer func() { log.Info("exited") }()
+
+	initLogger(ctx)
+	path := ctx.Args().First()
+	if path == "" {
+		return errors.New("snapshot file not specified")
+	}
+	if !ctx.IsSet(snapshotBlockIDFlag.Name) {
+		return fmt.Errorf("flag %s not specified", snapshotBlockIDFlag.Name)
+	}
+	blockID, err := thor.ParseBytes32(ctx.String(snapshotBlockIDFlag.Name))
+	if err != nil {
+		return errors.Wrap(err, "parse block id")
+	}
+
+	inst, err := openOfflineInstance(ctx)
+	if err != nil {
+		return err
+	}
+	defer inst.Close()
+
+	file, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "open snapshot file")
+	}
+	defer file.Close()
+
+	gr, err := gzip.NewReader(bufio.NewReader(file))
+	if err != nil {
+		return errors.Wrap(err, "open gzip stream")
+	}
+	defer gr.Close()
+
+	fmt.Printf(">> Importing snapshot from %v <<\n", path)
+	header, err := snapshot.Import(exitSignal, gr, inst.mainDB, inst.repo, blockID)
+	if err != nil {
+		return err
+	}
+
+	// write logs of restored blocks before setting the best block, so that the log db is in sync
+	// with the chain once the best block is set. If interrupted, the best block is still genesis, and
+	// the import can be run again. Logs before the first restored block are absent.
+	snapshotChain := inst.repo.NewChain(header.BlockID)
+	best := block.Number(header.BlockID)
+	first := best + 1 - header.Blocks
+	if first == 0 {
+		first = 1 // logs of genesis already written
+	}
+	if err := inst.logDB.Log(func(w *logdb.Writer) error {
+		for n := first; n <= best; n++ {
+			b, err := snapshotChain.GetBlock(n)
+			if err != nil {
+				return err
+			}
+			receipts, err := inst.repo.GetBlockReceipts(b.Header().ID())
+			if err != nil {
+				return errors.Wrap(err, "get block receipts")
+			}
+			if err := w.Write(b, receipts); err != nil {
+				return err
+			}
+			if w.Len() > 2048 {
+				if err := w.Flush(); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "write logs")
+	}
+
+	if err := inst.repo.SetBestBlockID(header.BlockID); err != nil {
+		return errors.Wrap(err, "set best block")
+	}
+
+	fmt.Printf("Snapshot imported, best block %v %v\n", best, header.BlockID)
+	return nil
+}
@@ -34,6 +34,7 @@ type Solo struct {
 	repo        *chain.Repository
 	txPool      *txpool.TxPool
 	packer      *packer.Packer
+	strategy    packer.Strategy
 	logDB       *logdb.LogDB
 	gasLimit    uint64
 	bandwidth   bandwidth.Bandwidth
@@ -51,10 +52,12 @@ func New(
 	onDemand bool,
 	skipLogs bool,
 	forkConfig thor.ForkConfig,
+	strategy packer.Strategy,
 ) *Solo {
 	return &Solo{
-		repo:   repo,
-		txPool: txPool,
+		repo:     repo,
+		txPool:   txPool,
+		strategy: strategy,
 		packer: packer.New(
 			repo,
 			stater,
@@ -113,13 +116,6 @@ func (s *Solo) packing(pendingTxs tx.Transactions, onDemand bool) error {
 	best := s.repo.BestBlock()
 	now := uint64(time.Now().Unix())
 
-	var txsToRemove []*tx.Transaction
-	defer func() {
-		for _, tx := range txsToRemove {
-			s.txPool.Remove(tx.Hash(), tx.ID())
-		}
-	}()
-
 	if s.gasLimit == 0 {
 		suggested := s.bandwidth.SuggestGasLimit()
 		s.packer.SetTargetGasLimit(suggested)
@@ -130,19 +126,19 @@ func (s *Solo) packing(pendingTxs tx.Transactions, onDemand bool) error {
 		return errors.WithMessage(err, "mock packer")
 	}
 
-	startTime := mclock.Now()
+	candidates := make([]*packer.Candidate, 0, len(pendingTxs))
 	for _, tx := range pendingTxs {
-		if err := flow.Adopt(tx); err != nil {
-			if packer.IsGasLimitReached(err) {
-				break
-			}
-			if packer.IsTxNotAdoptableNow(err) {
-				continue
-			}
-			txsToRemove = append(txsToRemove, tx)
-		}
+		candidates = append(candidates, &packer.Candidate{Tx: tx, Arrival: s.txPool.TimeAdded(tx.ID())})
 	}
 
+	startTime := mclock.Now()
+	txsToRemove := s.strategy.Fill(flow, candidates)
+	defer func() {
+		for _, tx := range txsToRemove {
+			s.txPool.Remove(tx.Hash(), tx.ID())
+		}
+	}()
+
 	b, stage, receipts, err := flow.Pack(genesis.DevAccounts()[0].PrivateKey)
 	if err != nil {
 		return errors.WithMessage(err, "pack")
@@ -43,6 +43,7 @@ import (
 	"github.com/vechain/thor/comm"
 	"github.com/vechain/thor/genesis"
 	"github.com/vechain/thor/logdb"
+	"github.com/vechain/thor/metric"
 	"github.com/vechain/thor/muxdb"
 	"github.com/vechain/thor/p2psrv"
 	"github.com/vechain/thor/state"
@@ -406,6 +407,18 @@ type p2pComm struct {
 	enode          string
 }
 
+func loadTxPoolPolicy(ctx *cli.Context) (txpool.Policy, error) {
+	path := ctx.String(txPoolPolicyFlag.Name)
+	if path == "" {
+		return nil, nil
+	}
+	policy, err := txpool.LoadPolicy(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "load txpool policy")
+	}
+	return policy, nil
+}
+
 func newP2PComm(ctx *cli.Context, repo *chain.Repository, txPool *txpool.TxPool, instanceDir string) (*p2pComm, error) {
 	configDir, err := makeConfigDir(ctx)
 	if err != nil {
@@ -456,7 +469,7 @@ func newP2PComm(ctx *cli.Context, repo *chain.Repository, txPool *txpool.TxPool,
 	}
 
 	return &p2pComm{
-		comm:           comm.New(repo, txPool),
+		comm:           comm.New(repo, txPool, time.Duration(ctx.Int(txPoolRebroadcastFlag.Name))*time.Second),
 		p2pSrv:         p2psrv.New(opts),
 		peersCachePath: peersCachePath,
 		enode:          fmt.Sprintf("enode://%x@[extip]:%v", discover.PubkeyID(&key.PublicKey).Bytes(), ctx.Int(p2pPortFlag.Name)),
@@ -515,6 +528,108 @@ func startAPIServer(ctx *cli.Context, handler http.Handler, genesisID thor.Bytes
 	}, nil
 }
 
+// initMetrics registers metrics which are computed on each scrape.
+// comm can be nil if P2P networking is not running.
+func initMetrics(repo *chain.Repository, txPool *txpool.TxPool, mainDB *muxdb.MuxDB, comm *comm.Communicator) {
+	metric.NewGaugeFunc("thor_chain_best_block_number", "Number of the best block.", func() float64 {
+		return float64(repo.BestBlock().Header().Number())
+	})
+	metric.NewGaugeFunc("thor_chain_best_block_lag_seconds", "Seconds elapsed since the best block timestamp.", func() float64 {
+		return float64(time.Now().Unix()) - float64(repo.BestBlock().Header().Timestamp())
+	})
+	metric.NewGaugeVecFunc("thor_txpool_txs", "Count of txs in the pool, by executability.", "status", func() map[string]float64 {
+		all, executables := txPool.Len(), len(txPool.Executables())
+		nonExecutables := all - executables
+		if nonExecutables < 0 {
+			// executables are cached in pool's housekeeping, so may be out-of-date
+			nonExecutables = 0
+		}
+		return map[string]float64{
+			"executable":     float64(executables),
+			"non-executable": float64(nonExecutables),
+		}
+	})
+	metric.NewCounterVecFunc("thor_trie_cache_hits_total", "Count of trie node cache hits.", "cache", func() map[string]float64 {
+		stats := mainDB.TrieCacheStats()
+		return map[string]float64{
+			"encoded": float64(stats.EncodedHits),
+			"decoded": float64(stats.DecodedHits),
+		}
+	})
+	metric.NewCounterVecFunc("thor_trie_cache_misses_total", "Count of trie node cache misses.", "cache", func() map[string]float64 {
+		stats := mainDB.TrieCacheStats()
+		return map[string]float64{
+			"encoded": float64(stats.EncodedMisses),
+			"decoded": float64(stats.DecodedMisses),
+		}
+	})
+	metric.NewGaugeVecFunc("thor_trie_cache_hit_ratio", "Ratio of trie node cache hits to lookups since startup.", "cache", func() map[string]float64 {
+		stats := mainDB.TrieCacheStats()
+		ratio := func(hits, misses uint64) float64 {
+			if hits+misses == 0 {
+				return 0
+			}
+			return float64(hits) / float64(hits+misses)
+		}
+		return map[string]float64{
+			"encoded": ratio(stats.EncodedHits, stats.EncodedMisses),
+			"decoded": ratio(stats.DecodedHits, stats.DecodedMisses),
+		}
+	})
+	if comm != nil {
+		metric.NewGaugeFunc("thor_p2p_peers", "Count of connected P2P peers.", func() float64 {
+			return float64(comm.PeerCount())
+		})
+	}
+}
+
+// startAdminServer starts the server to serve admin APIs.
+// It does nothing if the admin address is not set.
+func startAdminServer(ctx *cli.Context, handler http.Handler) (string, func(), error) {
+	addr := ctx.String(apiAdminAddrFlag.Name)
+	if addr == "" {
+		return "", func() {}, nil
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "listen admin API addr [%v]", addr)
+	}
+	handler = requestBodyLimit(handler)
+	srv := &http.Server{Handler: handler}
+	var goes co.Goes
+	goes.Go(func() {
+		srv.Serve(listener)
+	})
+	return "http://" + listener.Addr().String() + "/", func() {
+		srv.Close()
+		goes.Wait()
+	}, nil
+}
+
+// startMetricsServer starts the server to serve prometheus metrics at /metrics.
+// It does nothing if the metrics address is not set.
+func startMetricsServer(ctx *cli.Context) (string, func(), error) {
+	addr := ctx.String(metricsAddrFlag.Name)
+	if addr == "" {
+		return "", func() {}, nil
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "listen metrics addr [%v]", addr)
+	}
+	router := http.NewServeMux()
+	router.Handle("/metrics", metric.Handler())
+	srv := &http.Server{Handler: router}
+	var goes co.Goes
+	goes.Go(func() {
+		srv.Serve(listener)
+	})
+	return "http://" + listener.Addr().String() + "/metrics", func() {
+		srv.Close()
+		goes.Wait()
+	}, nil
+}
+
 func printStartupMessage1(
 	gene *genesis.Genesis,
 	repo *chain.Repository,
@@ -549,12 +664,20 @@ func printStartupMessage1(
 func printStartupMessage2(
 	apiURL string,
 	nodeID string,
+	adminURL string,
+	metricsURL string,
 ) {
 	fmt.Printf(`    API portal   [ %v ]
     Node ID      [ %v ]
 `,
 		apiURL,
 		nodeID)
+	if adminURL != "" {
+		fmt.Printf("    Admin API    [ %v ]\n", adminURL)
+	}
+	if metricsURL != "" {
+		fmt.Printf("    Metrics      [ %v ]\n", metricsURL)
+	}
 }
 
 func openMemMainDB() *muxdb.MuxDB {
@@ -574,6 +697,8 @@ func printSoloStartupMessage(
 	repo *chain.Repository,
 	dataDir string,
 	apiURL string,
+	adminURL string,
+	metricsURL string,
 	forkConfig thor.ForkConfig,
 ) {
 	tableHead := `
@@ -599,6 +724,14 @@ func printSoloStartupMessage(
 		forkConfig,
 		dataDir,
 		apiURL)
+	if adminURL != "" {
+		info += fmt.Sprintf(`
+    Admin API   [ %v ]`, adminURL)
+	}
+	if metricsURL != "" {
+		info += fmt.Sprintf(`
+    Metrics     [ %v ]`, metricsURL)
+	}
 
 	info += tableHead
 
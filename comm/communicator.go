@@ -32,6 +32,7 @@ var log = log15.New("pkg", "comm")
 type Communicator struct {
 	repo           *chain.Repository
 	txPool         *txpool.TxPool
+	txRebroadcast  time.Duration
 	ctx            context.Context
 	cancel         context.CancelFunc
 	peerSet        *PeerSet
@@ -44,11 +45,13 @@ type Communicator struct {
 }
 
 // New create a new Communicator instance.
-func New(repo *chain.Repository, txPool *txpool.TxPool) *Communicator {
+// Executable local txs are re-announced to peers every txRebroadcast, and it's disabled if txRebroadcast is 0.
+func New(repo *chain.Repository, txPool *txpool.TxPool, txRebroadcast time.Duration) *Communicator {
 	ctx, cancel := context.WithCancel(context.Background())
 	return &Communicator{
 		repo:           repo,
 		txPool:         txPool,
+		txRebroadcast:  txRebroadcast,
 		ctx:            ctx,
 		cancel:         cancel,
 		peerSet:        newPeerSet(),
@@ -145,6 +148,7 @@ func (c *Communicator) Protocols() []*p2psrv.Protocol {
 // Start start the communicator.
 func (c *Communicator) Start() {
 	c.goes.Go(c.txsLoop)
+	c.goes.Go(c.txsRebroadcastLoop)
 	c.goes.Go(c.announcementLoop)
 }
 
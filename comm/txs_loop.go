@@ -6,6 +6,9 @@
 package comm
 
 import (
+	"math"
+	"time"
+
 	"github.com/vechain/thor/comm/proto"
 	"github.com/vechain/thor/txpool"
 )
@@ -40,3 +43,44 @@ func (c *Communicator) txsLoop() {
 		}
 	}
 }
+
+// txsRebroadcastLoop re-announces executable local txs to a subset of peers periodically,
+// in case that they were lost before reaching the block proposers.
+func (c *Communicator) txsRebroadcastLoop() {
+	if c.txRebroadcast <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(c.txRebroadcast)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+			txs := c.txPool.LocalExecutables()
+			if len(txs) == 0 {
+				continue
+			}
+			// peers might have dropped txs, so known txs are not filtered out
+			peers := c.peerSet.Slice()
+			n := int(math.Ceil(math.Sqrt(float64(len(peers)))))
+			for _, peer := range peers[:n] {
+				peer := peer
+				for _, tx := range txs {
+					peer.MarkTransaction(tx.Hash())
+				}
+				c.goes.Go(func() {
+					for _, tx := range txs {
+						if err := proto.NotifyNewTx(c.ctx, peer, tx); err != nil {
+							peer.logger.Debug("failed to rebroadcast tx", "err", err)
+							return
+						}
+					}
+				})
+			}
+			log.Debug("rebroadcast local txs", "count", len(txs), "peers", n)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package finality evaluates finality of blocks, so that clients needn't invent their own confirmation rules.
+package finality
+
+import (
+	"sync"
+
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/poa"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+)
+
+// max count of blocks to look back for the finalized block.
+const maxDepth = 1000
+
+// Finality evaluates finality of blocks on the trunk.
+// A block is finalized, once more than 2/3 of active proposers have produced blocks on top of it.
+type Finality struct {
+	repo   *chain.Repository
+	stater *state.Stater
+
+	lock      sync.Mutex
+	bestID    thor.Bytes32
+	finalized *block.Header
+}
+
+// New creates a Finality object.
+func New(repo *chain.Repository, stater *state.Stater) *Finality {
+	return &Finality{
+		repo:   repo,
+		stater: stater,
+	}
+}
+
+// Finalized returns the latest finalized block on the trunk.
+func (f *Finality) Finalized() (*block.Header, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	best := f.repo.BestBlock().Header()
+	if f.finalized != nil && f.bestID == best.ID() {
+		return f.finalized, nil
+	}
+
+	finalized, err := f.evaluate(best)
+	if err != nil {
+		return nil, err
+	}
+
+	// never go back while the previous one is still on the trunk
+	if f.finalized != nil && f.finalized.Number() > finalized.Number() {
+		id, err := f.repo.NewChain(best.ID()).GetBlockID(f.finalized.Number())
+		if err != nil && !f.repo.IsNotFound(err) {
+			return nil, err
+		}
+		if id == f.finalized.ID() {
+			finalized = f.finalized
+		}
+	}
+	f.bestID = best.ID()
+	f.finalized = finalized
+	return finalized, nil
+}
+
+// evaluate walks back from the best block, until enough distinct proposers are seen.
+func (f *Finality) evaluate(best *block.Header) (*block.Header, error) {
+	st := f.stater.NewState(best.StateRoot())
+	list, err := builtin.Authority.Native(st).AllCandidates()
+	if err != nil {
+		return nil, err
+	}
+	proposers, err := poa.NewCandidates(list).Pick(st)
+	if err != nil {
+		return nil, err
+	}
+	actives := 0
+	for _, p := range proposers {
+		if p.Active {
+			actives++
+		}
+	}
+
+	signers := make(map[thor.Address]bool)
+	header := best
+	for i := 0; i < maxDepth && header.Number() > 0; i++ {
+		if len(signers)*3 > actives*2 {
+			return header, nil
+		}
+		signer, err := header.Signer()
+		if err != nil {
+			return nil, err
+		}
+		signers[signer] = true
+
+		summary, err := f.repo.GetBlockSummary(header.ParentID())
+		if err != nil {
+			return nil, err
+		}
+		header = summary.Header
+	}
+	if header.Number() > 0 {
+		// too deep
+		return f.repo.GenesisBlock().Header(), nil
+	}
+	return header, nil
+}
+
+// Status returns confirmations and finality of the block. Blocks not on the trunk have no confirmations.
+func (f *Finality) Status(header *block.Header) (confirmations uint32, finalized bool, err error) {
+	best := f.repo.BestBlock().Header()
+	id, err := f.repo.NewChain(best.ID()).GetBlockID(header.Number())
+	if err != nil {
+		if f.repo.IsNotFound(err) {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	if id != header.ID() {
+		return 0, false, nil
+	}
+
+	fin, err := f.Finalized()
+	if err != nil {
+		return 0, false, err
+	}
+	return best.Number() - header.Number(), header.Number() <= fin.Number(), nil
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package finality_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/finality"
+	"github.com/vechain/thor/testchain"
+	"github.com/vechain/thor/thor"
+)
+
+func TestFinality(t *testing.T) {
+	c, err := testchain.NewDevnet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, stater := c.Repo(), c.Stater()
+	b0 := repo.GenesisBlock()
+	newBlock := func(parent *block.Header, slot uint64) *block.Header {
+		blk, err := c.NewBlock(parent, parent.Timestamp()+slot*thor.BlockInterval)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return blk.Header()
+	}
+
+	fin := finality.New(repo, stater)
+	finalized, err := fin.Finalized()
+	assert.Nil(t, err)
+	assert.Equal(t, b0.Header().ID(), finalized.ID(), "genesis is always finalized")
+
+	b1 := newBlock(b0.Header(), 1)
+	b2 := newBlock(b1, 1)
+	repo.SetBestBlockID(b2.ID())
+
+	finalized, err = fin.Finalized()
+	assert.Nil(t, err)
+	assert.Equal(t, b1.ID(), finalized.ID())
+
+	confirmations, isFinalized, err := fin.Status(b1)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), confirmations)
+	assert.True(t, isFinalized)
+
+	confirmations, isFinalized, err = fin.Status(b2)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), confirmations)
+	assert.False(t, isFinalized)
+
+	// side chain
+	b2x := newBlock(b1, 2)
+	confirmations, isFinalized, err = fin.Status(b2x)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), confirmations)
+	assert.False(t, isFinalized)
+}
+
+func TestFinalityMultiAuthorities(t *testing.T) {
+	const authorities = 5
+	c, err := testchain.NewCustomNet(authorities)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := c.Repo()
+	fin := finality.New(repo, c.Stater())
+
+	best := repo.GenesisBlock().Header()
+	newBlock := func(slot uint64) thor.Address {
+		blk, err := c.NewBlock(best, best.Timestamp()+slot*thor.BlockInterval)
+		if err != nil {
+			t.Fatal(err)
+		}
+		best = blk.Header()
+		if err := repo.SetBestBlockID(best.ID()); err != nil {
+			t.Fatal(err)
+		}
+		signer, _ := best.Signer()
+		return signer
+	}
+	countActives := func() int {
+		list, err := builtin.Authority.Native(c.Stater().NewState(best.StateRoot())).AllCandidates()
+		if err != nil {
+			t.Fatal(err)
+		}
+		n := 0
+		for _, cand := range list {
+			if cand.Active {
+				n++
+			}
+		}
+		return n
+	}
+	// count of distinct signers of blocks on top of the given block
+	countSigners := func(header *block.Header) int {
+		signers := make(map[thor.Address]bool)
+		for h := best; h.Number() > header.Number(); {
+			signer, _ := h.Signer()
+			signers[signer] = true
+			summary, err := repo.GetBlockSummary(h.ParentID())
+			if err != nil {
+				t.Fatal(err)
+			}
+			h = summary.Header
+		}
+		return len(signers)
+	}
+	// produces blocks in consecutive slots, until all active proposers produce
+	produceAll := func() {
+		signers := make(map[thor.Address]bool)
+		for i := 0; i < 1000 && len(signers) < countActives(); i++ {
+			signers[newBlock(1)] = true
+		}
+	}
+	// the finalized block is the latest one, on top of which more than 2/3 of active proposers produce
+	checkFinalized := func() *block.Header {
+		finalized, err := fin.Finalized()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, finalized.Number() > 0)
+		actives := countActives()
+		assert.True(t, countSigners(finalized)*3 > actives*2)
+		next, err := repo.NewChain(best.ID()).GetBlockHeader(finalized.Number() + 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, countSigners(next)*3 <= actives*2)
+		return finalized
+	}
+
+	produceAll()
+	assert.Equal(t, authorities, countActives())
+	checkFinalized()
+
+	// proposers of skipped slots are deactivated
+	for i := 0; i < 1000 && countActives() > 3; i++ {
+		newBlock(2)
+	}
+	assert.Equal(t, 3, countActives())
+	produceAll()
+	finalized := checkFinalized()
+	// inactive proposers are not counted
+	assert.True(t, countSigners(finalized)*3 <= authorities*2)
+}
@@ -0,0 +1,269 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package liveness tracks block proposers, to tell whether they produce blocks in their turns.
+package liveness
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/kv"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/poa"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+)
+
+const storeName = "liveness.data"
+
+// Window the period in seconds, over which stats are counted.
+const Window = uint64(24 * 3600)
+
+var (
+	statsPrefix = []byte("s") // (prefix, address) -> stats
+	slotPrefix  = []byte("t") // (prefix, timestamp) -> slot
+)
+
+// Stats stats of a proposer in the window.
+type Stats struct {
+	Address    thor.Address
+	Produced   uint64 // count of blocks produced
+	Missed     uint64 // count of slots missed
+	LastSeen   uint64 // timestamp of the last block produced
+	LastMissed uint64 // timestamp of the last slot missed
+}
+
+// slot records how a slot is taken.
+type slot struct {
+	Proposer thor.Address
+	Missed   bool
+}
+
+// Tracker tracks trunk blocks, to count blocks produced and slots missed by each proposer.
+// Slots taken by a new block are overwritten, but slots after it, taken by blocks of the side chain, are left
+// as they are, since forks are rare.
+type Tracker struct {
+	repo   *chain.Repository
+	stater *state.Stater
+	store  kv.Store
+	lock   sync.Mutex
+	stats  map[thor.Address]*Stats
+}
+
+// New creates a tracker, with stats loaded from db.
+func New(repo *chain.Repository, stater *state.Stater, db *muxdb.MuxDB) (*Tracker, error) {
+	store := db.NewStore(storeName)
+	t := &Tracker{
+		repo:   repo,
+		stater: stater,
+		store:  store,
+		stats:  make(map[thor.Address]*Stats),
+	}
+
+	var loadErr error
+	if err := store.Iterate(kv.Range{Start: statsPrefix}, func(pair kv.Pair) bool {
+		if !bytes.HasPrefix(pair.Key(), statsPrefix) {
+			return false
+		}
+		var s Stats
+		if loadErr = rlp.DecodeBytes(pair.Value(), &s); loadErr != nil {
+			return false
+		}
+		t.stats[s.Address] = &s
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	if loadErr != nil {
+		return nil, loadErr
+	}
+	return t, nil
+}
+
+// Track tracks the new best block, and returns proposers who missed slots between the block and its parent.
+// Blocks out of the window are ignored, since they don't count.
+func (t *Tracker) Track(header *block.Header, now uint64) (missed []thor.Address, err error) {
+	if header.Timestamp()+Window <= now {
+		return nil, nil
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	signer, err := header.Signer()
+	if err != nil {
+		return nil, err
+	}
+	parent, err := t.repo.GetBlockSummary(header.ParentID())
+	if err != nil {
+		return nil, err
+	}
+	st := t.stater.NewState(parent.Header.StateRoot())
+	list, err := builtin.Authority.Native(st).AllCandidates()
+	if err != nil {
+		return nil, err
+	}
+	proposers, err := poa.NewCandidates(list).Pick(st)
+	if err != nil {
+		return nil, err
+	}
+	sched, err := poa.NewScheduler(signer, proposers, parent.Header.Number(), parent.Header.Timestamp())
+	if err != nil {
+		return nil, err
+	}
+
+	slots := map[uint64]*slot{
+		header.Timestamp(): {Proposer: signer},
+	}
+	// slots before the block, and at most thor.MaxBlockProposers, as consensus does
+	ts := header.Timestamp() - thor.BlockInterval
+	for i := uint64(0); i < thor.MaxBlockProposers && ts > parent.Header.Timestamp(); i++ {
+		p := sched.WhoseTurn(ts)
+		slots[ts] = &slot{Proposer: p.Address, Missed: true}
+		missed = append(missed, p.Address)
+		ts -= thor.BlockInterval
+	}
+
+	// slots to be reverted, which are out of the window, or to be overwritten
+	reverted := make(map[uint64]*slot)
+	if header.Timestamp() > Window {
+		var decodeErr error
+		end := slotKey(header.Timestamp() - Window)
+		if err := t.store.Iterate(kv.Range{Start: slotKey(0)}, func(pair kv.Pair) bool {
+			if bytes.Compare(pair.Key(), end) > 0 {
+				return false
+			}
+			var s slot
+			if decodeErr = rlp.DecodeBytes(pair.Value(), &s); decodeErr != nil {
+				return false
+			}
+			reverted[binary.BigEndian.Uint64(pair.Key()[len(slotPrefix):])] = &s
+			return true
+		}); err != nil {
+			return nil, err
+		}
+		if decodeErr != nil {
+			return nil, decodeErr
+		}
+	}
+	for ts := range slots {
+		// the slot was taken by blocks of a side chain, or this block is tracked again
+		data, err := t.store.Get(slotKey(ts))
+		if err != nil {
+			if t.store.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		var s slot
+		if err := rlp.DecodeBytes(data, &s); err != nil {
+			return nil, err
+		}
+		reverted[ts] = &s
+	}
+
+	updated := make(map[thor.Address]*Stats)
+	get := func(addr thor.Address) *Stats {
+		s, ok := updated[addr]
+		if !ok {
+			if s, ok = t.stats[addr]; ok {
+				cpy := *s
+				s = &cpy
+			} else {
+				s = &Stats{Address: addr}
+			}
+			updated[addr] = s
+		}
+		return s
+	}
+	for _, sl := range reverted {
+		s := get(sl.Proposer)
+		if sl.Missed {
+			if s.Missed > 0 {
+				s.Missed--
+			}
+		} else if s.Produced > 0 {
+			s.Produced--
+		}
+	}
+	for ts, sl := range slots {
+		s := get(sl.Proposer)
+		if sl.Missed {
+			s.Missed++
+			if ts > s.LastMissed {
+				s.LastMissed = ts
+			}
+		} else {
+			s.Produced++
+			if ts > s.LastSeen {
+				s.LastSeen = ts
+			}
+		}
+	}
+
+	if err := t.store.Batch(func(w kv.PutFlusher) error {
+		for ts := range reverted {
+			if err := w.Delete(slotKey(ts)); err != nil {
+				return err
+			}
+		}
+		for ts, sl := range slots {
+			data, err := rlp.EncodeToBytes(sl)
+			if err != nil {
+				return err
+			}
+			if err := w.Put(slotKey(ts), data); err != nil {
+				return err
+			}
+		}
+		for addr, s := range updated {
+			data, err := rlp.EncodeToBytes(s)
+			if err != nil {
+				return err
+			}
+			if err := w.Put(append(append([]byte(nil), statsPrefix...), addr[:]...), data); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	for addr, s := range updated {
+		t.stats[addr] = s
+	}
+	return missed, nil
+}
+
+// Stats returns stats of all tracked proposers, ordered by address.
+func (t *Tracker) Stats() []*Stats {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	all := make([]*Stats, 0, len(t.stats))
+	for _, s := range t.stats {
+		cpy := *s
+		all = append(all, &cpy)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return bytes.Compare(all[i].Address[:], all[j].Address[:]) < 0
+	})
+	return all
+}
+
+func slotKey(ts uint64) []byte {
+	k := append([]byte(nil), slotPrefix...)
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], ts)
+	return append(k, b[:]...)
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package liveness_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/liveness"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/testchain"
+	"github.com/vechain/thor/thor"
+)
+
+func newChain(t *testing.T) (*muxdb.MuxDB, *state.Stater, *chain.Repository, func(parent *block.Header, timestamp uint64) *block.Header) {
+	c, err := testchain.NewDevnet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c.DB(), c.Stater(), c.Repo(), func(parent *block.Header, timestamp uint64) *block.Header {
+		blk, err := c.NewBlock(parent, timestamp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return blk.Header()
+	}
+}
+
+func TestTracker(t *testing.T) {
+	db, stater, repo, newBlock := newChain(t)
+	b0 := repo.GenesisBlock()
+	master := genesis.DevAccounts()[0]
+
+	tracker, err := liveness.New(repo, stater, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// skip 2 slots
+	b1 := newBlock(b0.Header(), b0.Header().Timestamp()+3*thor.BlockInterval)
+	missed, err := tracker.Track(b1, b1.Timestamp())
+	assert.Nil(t, err)
+	assert.Equal(t, []thor.Address{master.Address, master.Address}, missed)
+
+	want := []*liveness.Stats{{
+		Address:    master.Address,
+		Produced:   1,
+		Missed:     2,
+		LastSeen:   b1.Timestamp(),
+		LastMissed: b1.Timestamp() - thor.BlockInterval,
+	}}
+	assert.Equal(t, want, tracker.Stats())
+
+	// tracked again
+	_, err = tracker.Track(b1, b1.Timestamp())
+	assert.Nil(t, err)
+	assert.Equal(t, want, tracker.Stats())
+
+	// out of window
+	missed, err = tracker.Track(b1, b1.Timestamp()+liveness.Window)
+	assert.Nil(t, err)
+	assert.Nil(t, missed)
+
+	// reloaded
+	tracker, err = liveness.New(repo, stater, db)
+	assert.Nil(t, err)
+	assert.Equal(t, want, tracker.Stats())
+
+	// slots of b1 expired
+	b2 := newBlock(b1, b1.Timestamp()+liveness.Window+thor.BlockInterval)
+	missed, err = tracker.Track(b2, b2.Timestamp())
+	assert.Nil(t, err)
+	assert.Equal(t, int(thor.MaxBlockProposers), len(missed))
+	stats := tracker.Stats()
+	assert.Equal(t, uint64(1), stats[0].Produced)
+	assert.Equal(t, thor.MaxBlockProposers, stats[0].Missed)
+	assert.Equal(t, b2.Timestamp(), stats[0].LastSeen)
+}
+
+func TestLoadStats(t *testing.T) {
+	db, stater, repo, newBlock := newChain(t)
+	b0 := repo.GenesisBlock().Header()
+
+	tracker, err := liveness.New(repo, stater, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// slots are stored next to stats
+	b1 := newBlock(b0, b0.Timestamp()+3*thor.BlockInterval)
+	if _, err := tracker.Track(b1, b1.Timestamp()); err != nil {
+		t.Fatal(err)
+	}
+
+	// only stats are loaded
+	loaded, err := liveness.New(repo, stater, db)
+	assert.Nil(t, err)
+	assert.Equal(t, tracker.Stats(), loaded.Stats())
+	assert.Equal(t, 1, len(loaded.Stats()))
+}
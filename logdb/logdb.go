@@ -9,7 +9,6 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"math"
 	"math/big"
 
 	sqlite3 "github.com/mattn/go-sqlite3"
@@ -89,32 +88,16 @@ FROM (%v) e
 		return db.queryEvents(ctx, fmt.Sprintf(query, "event"))
 	}
 
-	var (
-		subQuery = "SELECT seq FROM event WHERE 1"
-		args     []interface{}
-	)
+	cond, args := filter.toWhereCondition()
+	subQuery := "SELECT seq FROM event WHERE " + cond
 
-	if filter.Range != nil {
-		subQuery += " AND seq >= ?"
-		args = append(args, newSequence(filter.Range.From, 0))
-		if filter.Range.To >= filter.Range.From {
-			subQuery += " AND seq <= ?"
-			args = append(args, newSequence(filter.Range.To, uint32(math.MaxInt32)))
-		}
-	}
-
-	if len(filter.CriteriaSet) > 0 {
-		subQuery += " AND ("
-
-		for i, c := range filter.CriteriaSet {
-			cond, cargs := c.toWhereCondition()
-			if i > 0 {
-				subQuery += " OR"
-			}
-			subQuery += " (" + cond + ")"
-			args = append(args, cargs...)
+	if filter.Options != nil {
+		cursorCond, cursorArgs, err := filter.Options.cursorCondition(filter.Order)
+		if err != nil {
+			return nil, err
 		}
-		subQuery += ")"
+		subQuery += cursorCond
+		args = append(args, cursorArgs...)
 	}
 
 	if filter.Order == DESC {
@@ -147,31 +130,16 @@ FROM (%v) t
 		return db.queryTransfers(ctx, fmt.Sprintf(query, "transfer"))
 	}
 
-	var (
-		subQuery = "SELECT seq FROM transfer WHERE 1"
-		args     []interface{}
-	)
-
-	if filter.Range != nil {
-		subQuery += " AND seq >= ?"
-		args = append(args, newSequence(filter.Range.From, 0))
-		if filter.Range.To >= filter.Range.From {
-			subQuery += " AND seq <= ?"
-			args = append(args, newSequence(filter.Range.To, uint32(math.MaxInt32)))
-		}
-	}
+	cond, args := filter.toWhereCondition()
+	subQuery := "SELECT seq FROM transfer WHERE " + cond
 
-	if len(filter.CriteriaSet) > 0 {
-		subQuery += " AND ("
-		for i, c := range filter.CriteriaSet {
-			cond, cargs := c.toWhereCondition()
-			if i > 0 {
-				subQuery += " OR"
-			}
-			subQuery += " (" + cond + ")"
-			args = append(args, cargs...)
+	if filter.Options != nil {
+		cursorCond, cursorArgs, err := filter.Options.cursorCondition(filter.Order)
+		if err != nil {
+			return nil, err
 		}
-		subQuery += ")"
+		subQuery += cursorCond
+		args = append(args, cursorArgs...)
 	}
 
 	if filter.Order == DESC {
@@ -189,6 +157,40 @@ FROM (%v) t
 	return db.queryTransfers(ctx, fmt.Sprintf(query, subQuery), args...)
 }
 
+// CountEvents returns the count of events matching the filter.
+// Options and order of the filter are ignored.
+func (db *LogDB) CountEvents(ctx context.Context, filter *EventFilter) (uint64, error) {
+	query := "SELECT COUNT(*) FROM event"
+	var args []interface{}
+	if filter != nil {
+		var cond string
+		cond, args = filter.toWhereCondition()
+		query += " WHERE " + cond
+	}
+	return db.queryCount(ctx, query, args...)
+}
+
+// CountTransfers returns the count of transfers matching the filter.
+// Options and order of the filter are ignored.
+func (db *LogDB) CountTransfers(ctx context.Context, filter *TransferFilter) (uint64, error) {
+	query := "SELECT COUNT(*) FROM transfer"
+	var args []interface{}
+	if filter != nil {
+		var cond string
+		cond, args = filter.toWhereCondition()
+		query += " WHERE " + cond
+	}
+	return db.queryCount(ctx, query, args...)
+}
+
+func (db *LogDB) queryCount(ctx context.Context, query string, args ...interface{}) (uint64, error) {
+	var count uint64
+	if err := db.db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 func (db *LogDB) queryEvents(ctx context.Context, query string, args ...interface{}) ([]*Event, error) {
 	rows, err := db.db.QueryContext(ctx, query, args...)
 	if err != nil {
@@ -161,13 +161,20 @@ func TestEvents(t *testing.T) {
 			{"query all events asc", &logdb.EventFilter{Order: logdb.ASC}, allEvents},
 			{"query all events desc", &logdb.EventFilter{Order: logdb.DESC}, allEvents.Reverse()},
 			{"query all events limit offset", &logdb.EventFilter{Options: &logdb.Options{Offset: 1, Limit: 10}}, allEvents[1:11]},
+			{"query all events cursor asc", &logdb.EventFilter{Options: &logdb.Options{Limit: 10, Cursor: &logdb.Cursor{BlockNumber: allEvents[4].BlockNumber, Index: allEvents[4].Index}}}, allEvents[5:15]},
+			{"query all events cursor desc", &logdb.EventFilter{Order: logdb.DESC, Options: &logdb.Options{Limit: 10, Cursor: &logdb.Cursor{BlockNumber: allEvents[15].BlockNumber, Index: allEvents[15].Index}}}, allEvents[5:15].Reverse()},
 			{"query all events range", &logdb.EventFilter{Range: &logdb.Range{From: 10, To: 20}}, allEvents.Filter(func(ev *logdb.Event) bool { return ev.BlockNumber >= 10 && ev.BlockNumber <= 20 })},
-			{"query all events with criteria", &logdb.EventFilter{CriteriaSet: []*logdb.EventCriteria{{Address: &allEvents[1].Address}}}, allEvents.Filter(func(ev *logdb.Event) bool {
+			{"query all events with criteria", &logdb.EventFilter{CriteriaSet: []*logdb.EventCriteria{{Address: []thor.Address{allEvents[1].Address}}}}, allEvents.Filter(func(ev *logdb.Event) bool {
 				return ev.Address == allEvents[1].Address
 			})},
-			{"query all events with multi-criteria", &logdb.EventFilter{CriteriaSet: []*logdb.EventCriteria{{Address: &allEvents[1].Address}, {Topics: [5]*thor.Bytes32{allEvents[2].Topics[0]}}, {Topics: [5]*thor.Bytes32{allEvents[3].Topics[0]}}}}, allEvents.Filter(func(ev *logdb.Event) bool {
+			{"query all events with multi-criteria", &logdb.EventFilter{CriteriaSet: []*logdb.EventCriteria{{Address: []thor.Address{allEvents[1].Address}}, {Topics: [5][]thor.Bytes32{{*allEvents[2].Topics[0]}}}, {Topics: [5][]thor.Bytes32{{*allEvents[3].Topics[0]}}}}}, allEvents.Filter(func(ev *logdb.Event) bool {
 				return ev.Address == allEvents[1].Address || *ev.Topics[0] == *allEvents[2].Topics[0] || *ev.Topics[0] == *allEvents[3].Topics[0]
 			})},
+			{"query all events with address set", &logdb.EventFilter{CriteriaSet: []*logdb.EventCriteria{{Address: []thor.Address{allEvents[1].Address, allEvents[5].Address, allEvents[9].Address}}}}, eventLogs{allEvents[1], allEvents[5], allEvents[9]}},
+			{"query all events with address and topic set", &logdb.EventFilter{CriteriaSet: []*logdb.EventCriteria{{
+				Address: []thor.Address{allEvents[1].Address, allEvents[5].Address, allEvents[9].Address},
+				Topics:  [5][]thor.Bytes32{{*allEvents[1].Topics[0], *allEvents[9].Topics[0], *allEvents[10].Topics[0]}},
+			}}}, eventLogs{allEvents[1], allEvents[9]}},
 		}
 
 		for _, tt := range tests {
@@ -189,6 +196,8 @@ func TestEvents(t *testing.T) {
 			{"query all transfers asc", &logdb.TransferFilter{Order: logdb.ASC}, allTransfers},
 			{"query all transfers desc", &logdb.TransferFilter{Order: logdb.DESC}, allTransfers.Reverse()},
 			{"query all transfers limit offset", &logdb.TransferFilter{Options: &logdb.Options{Offset: 1, Limit: 10}}, allTransfers[1:11]},
+			{"query all transfers cursor asc", &logdb.TransferFilter{Options: &logdb.Options{Limit: 10, Cursor: &logdb.Cursor{BlockNumber: allTransfers[4].BlockNumber, Index: allTransfers[4].Index}}}, allTransfers[5:15]},
+			{"query all transfers cursor desc", &logdb.TransferFilter{Order: logdb.DESC, Options: &logdb.Options{Limit: 10, Cursor: &logdb.Cursor{BlockNumber: allTransfers[15].BlockNumber, Index: allTransfers[15].Index}}}, allTransfers[5:15].Reverse()},
 			{"query all transfers range", &logdb.TransferFilter{Range: &logdb.Range{From: 10, To: 20}}, allTransfers.Filter(func(tr *logdb.Transfer) bool { return tr.BlockNumber >= 10 && tr.BlockNumber <= 20 })},
 			{"query all transfers with criteria", &logdb.TransferFilter{CriteriaSet: []*logdb.TransferCriteria{{Sender: &allTransfers[1].Sender}}}, allTransfers.Filter(func(tr *logdb.Transfer) bool {
 				return tr.Sender == allTransfers[1].Sender
@@ -206,4 +215,34 @@ func TestEvents(t *testing.T) {
 			})
 		}
 	}
+
+	{
+		count, err := db.CountEvents(context.Background(), nil)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(len(allEvents)), count)
+
+		count, err = db.CountEvents(context.Background(), &logdb.EventFilter{
+			Range:       &logdb.Range{From: 10, To: 20},
+			CriteriaSet: []*logdb.EventCriteria{{Address: []thor.Address{allEvents[20].Address}}},
+			Options:     &logdb.Options{Limit: 0},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(1), count, "options should be ignored")
+
+		count, err = db.CountTransfers(context.Background(), &logdb.TransferFilter{Range: &logdb.Range{From: 10, To: 20}})
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(len(allTransfers.Filter(func(tr *logdb.Transfer) bool { return tr.BlockNumber >= 10 && tr.BlockNumber <= 20 }))), count)
+	}
+}
+
+func TestCursor(t *testing.T) {
+	c := &logdb.Cursor{BlockNumber: 100, Index: 2}
+	parsed, err := logdb.ParseCursor(c.String())
+	assert.Nil(t, err)
+	assert.Equal(t, c, parsed)
+
+	_, err = logdb.ParseCursor("0x1234")
+	assert.NotNil(t, err)
+	_, err = logdb.ParseCursor("0x00000001ffffffff")
+	assert.NotNil(t, err)
 }
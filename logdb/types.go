@@ -6,9 +6,14 @@
 package logdb
 
 import (
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"math"
 	"math/big"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/vechain/thor/thor"
 )
 
@@ -52,31 +57,109 @@ type Range struct {
 	To   uint32
 }
 
+func rangeCondition(r *Range) (cond string, args []interface{}) {
+	cond = "1"
+	if r != nil {
+		cond += " AND seq >= ?"
+		args = append(args, newSequence(r.From, 0))
+		if r.To >= r.From {
+			cond += " AND seq <= ?"
+			args = append(args, newSequence(r.To, uint32(math.MaxInt32)))
+		}
+	}
+	return
+}
+
 type Options struct {
 	Offset uint64
 	Limit  uint64
+	// if set, only rows after the cursor, in the order of the filter, are returned.
+	Cursor *Cursor
+}
+
+func (o *Options) cursorCondition(order Order) (cond string, args []interface{}, err error) {
+	if o.Cursor == nil {
+		return
+	}
+	if (o.Cursor.Index & math.MaxInt32) != o.Cursor.Index {
+		return "", nil, errors.New("invalid cursor")
+	}
+	if order == DESC {
+		cond = " AND seq < ?"
+	} else {
+		cond = " AND seq > ?"
+	}
+	args = append(args, newSequence(o.Cursor.BlockNumber, o.Cursor.Index))
+	return
+}
+
+// Cursor points to a row of event or transfer, to resume the query from it.
+type Cursor struct {
+	BlockNumber uint32
+	Index       uint32
+}
+
+// String returns the opaque string form of the cursor.
+func (c *Cursor) String() string {
+	var b [8]byte
+	binary.BigEndian.PutUint32(b[:], c.BlockNumber)
+	binary.BigEndian.PutUint32(b[4:], c.Index)
+	return hexutil.Encode(b[:])
 }
 
+// ParseCursor parses the string form of cursor.
+func ParseCursor(s string) (*Cursor, error) {
+	b, err := hexutil.Decode(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != 8 {
+		return nil, errors.New("invalid cursor length")
+	}
+	c := &Cursor{
+		BlockNumber: binary.BigEndian.Uint32(b),
+		Index:       binary.BigEndian.Uint32(b[4:]),
+	}
+	if (c.Index & math.MaxInt32) != c.Index {
+		return nil, errors.New("invalid cursor")
+	}
+	return c, nil
+}
+
+// EventCriteria matches events with any of the addresses, and any of the topics at each position.
+// Empty set matches all.
 type EventCriteria struct {
-	Address *thor.Address // always a contract address
-	Topics  [5]*thor.Bytes32
+	Address []thor.Address // always contract addresses
+	Topics  [5][]thor.Bytes32
 }
 
 func (c *EventCriteria) toWhereCondition() (cond string, args []interface{}) {
 	cond = "1"
-	if c.Address != nil {
-		cond += " AND address = " + refIDQuery
-		args = append(args, c.Address.Bytes())
-	}
-	for i, topic := range c.Topics {
-		if topic != nil {
-			cond += fmt.Sprintf(" AND topic%v = ", i) + refIDQuery
-			args = append(args, topic.Bytes())
+	if len(c.Address) > 0 {
+		cond += " AND address" + refIDSetCondition(len(c.Address))
+		for _, addr := range c.Address {
+			args = append(args, addr.Bytes())
+		}
+	}
+	for i, topics := range c.Topics {
+		if len(topics) > 0 {
+			cond += fmt.Sprintf(" AND topic%v", i) + refIDSetCondition(len(topics))
+			for _, topic := range topics {
+				args = append(args, topic.Bytes())
+			}
 		}
 	}
 	return
 }
 
+// refIDSetCondition returns the condition to match ref id of any of n values.
+func refIDSetCondition(n int) string {
+	if n == 1 {
+		return " = " + refIDQuery
+	}
+	return " IN (SELECT id FROM ref WHERE data IN (?" + strings.Repeat(",?", n-1) + "))"
+}
+
 //EventFilter filter
 type EventFilter struct {
 	CriteriaSet []*EventCriteria
@@ -85,6 +168,23 @@ type EventFilter struct {
 	Order       Order //default asc
 }
 
+func (f *EventFilter) toWhereCondition() (cond string, args []interface{}) {
+	cond, args = rangeCondition(f.Range)
+	if len(f.CriteriaSet) > 0 {
+		cond += " AND ("
+		for i, c := range f.CriteriaSet {
+			ccond, cargs := c.toWhereCondition()
+			if i > 0 {
+				cond += " OR"
+			}
+			cond += " (" + ccond + ")"
+			args = append(args, cargs...)
+		}
+		cond += ")"
+	}
+	return
+}
+
 type TransferCriteria struct {
 	TxOrigin  *thor.Address //who send transaction
 	Sender    *thor.Address //who transferred tokens
@@ -114,3 +214,20 @@ type TransferFilter struct {
 	Options     *Options
 	Order       Order //default asc
 }
+
+func (f *TransferFilter) toWhereCondition() (cond string, args []interface{}) {
+	cond, args = rangeCondition(f.Range)
+	if len(f.CriteriaSet) > 0 {
+		cond += " AND ("
+		for i, c := range f.CriteriaSet {
+			ccond, cargs := c.toWhereCondition()
+			if i > 0 {
+				cond += " OR"
+			}
+			cond += " (" + ccond + ")"
+			args = append(args, cargs...)
+		}
+		cond += ")"
+	}
+	return
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package metric
+
+import (
+	"bufio"
+	"sort"
+)
+
+// funcMetric is a metric whose values are computed on each scrape.
+type funcMetric struct {
+	n     string
+	help  string
+	typ   string
+	label string
+	fn    func() map[string]float64
+}
+
+func (m *funcMetric) name() string { return m.n }
+
+func (m *funcMetric) writeTo(w *bufio.Writer) {
+	values := m.fn()
+	writeHeader(w, m.n, m.help, m.typ)
+	if m.label == "" {
+		writeSample(w, m.n, nil, values[""])
+		return
+	}
+
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		writeSample(w, m.n, []string{m.label, k}, values[k])
+	}
+}
+
+func newFuncMetric(name, help, typ, label string, fn func() map[string]float64) {
+	defaultRegistry.mustRegister(&funcMetric{name, help, typ, label, fn})
+}
+
+// NewGaugeFunc registers a gauge whose value is computed by fn on each scrape.
+func NewGaugeFunc(name, help string, fn func() float64) {
+	newFuncMetric(name, help, "gauge", "", func() map[string]float64 {
+		return map[string]float64{"": fn()}
+	})
+}
+
+// NewCounterFunc registers a counter whose value is computed by fn on each scrape.
+// The value returned by fn should never decrease.
+func NewCounterFunc(name, help string, fn func() float64) {
+	newFuncMetric(name, help, "counter", "", func() map[string]float64 {
+		return map[string]float64{"": fn()}
+	})
+}
+
+// NewGaugeVecFunc registers a gauge partitioned by one label.
+// fn returns values keyed by label value.
+func NewGaugeVecFunc(name, help, label string, fn func() map[string]float64) {
+	newFuncMetric(name, help, "gauge", label, fn)
+}
+
+// NewCounterVecFunc registers a counter partitioned by one label.
+// fn returns values keyed by label value.
+func NewCounterVecFunc(name, help, label string, fn func() map[string]float64) {
+	newFuncMetric(name, help, "counter", label, fn)
+}
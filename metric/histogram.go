@@ -0,0 +1,171 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package metric
+
+import (
+	"bufio"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefBuckets are the default histogram buckets, in seconds.
+var DefBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// Histogram counts observations into configurable buckets.
+type Histogram struct {
+	upperBounds []float64
+
+	lock   sync.Mutex
+	counts []uint64 // non-cumulative count of each bucket, the last one is for +Inf
+	count  uint64
+	sum    float64
+}
+
+func newHistogram(buckets []float64) *Histogram {
+	upperBounds := append([]float64(nil), buckets...)
+	sort.Float64s(upperBounds)
+	return &Histogram{
+		upperBounds: upperBounds,
+		counts:      make([]uint64, len(upperBounds)+1),
+	}
+}
+
+// Observe adds a single observation.
+func (h *Histogram) Observe(v float64) {
+	i := sort.SearchFloat64s(h.upperBounds, v)
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.counts[i]++
+	h.count++
+	h.sum += v
+}
+
+func (h *Histogram) writeSamples(w *bufio.Writer, name string, labels []string) {
+	h.lock.Lock()
+	counts := append([]uint64(nil), h.counts...)
+	count, sum := h.count, h.sum
+	h.lock.Unlock()
+
+	var cumulative uint64
+	for i, c := range counts {
+		cumulative += c
+		le := math.Inf(1)
+		if i < len(h.upperBounds) {
+			le = h.upperBounds[i]
+		}
+		writeSample(w, name+"_bucket", append(labels, "le", formatFloat(le)), float64(cumulative))
+	}
+	writeSample(w, name+"_sum", labels, sum)
+	writeSample(w, name+"_count", labels, float64(count))
+}
+
+type histogramMetric struct {
+	n    string
+	help string
+	h    *Histogram
+}
+
+func (m *histogramMetric) name() string { return m.n }
+
+func (m *histogramMetric) writeTo(w *bufio.Writer) {
+	writeHeader(w, m.n, m.help, "histogram")
+	m.h.writeSamples(w, m.n, nil)
+}
+
+// NewHistogram creates and registers a histogram.
+// DefBuckets is used if buckets is empty.
+func NewHistogram(name, help string, buckets []float64) *Histogram {
+	if len(buckets) == 0 {
+		buckets = DefBuckets
+	}
+	h := newHistogram(buckets)
+	defaultRegistry.mustRegister(&histogramMetric{name, help, h})
+	return h
+}
+
+// HistogramVec is a set of histograms partitioned by labels.
+type HistogramVec struct {
+	n          string
+	help       string
+	buckets    []float64
+	labelNames []string
+
+	lock     sync.Mutex
+	children map[string]*histogramChild
+}
+
+type histogramChild struct {
+	labelValues []string
+	h           *Histogram
+}
+
+// NewHistogramVec creates and registers a histogram vector.
+// DefBuckets is used if buckets is empty.
+func NewHistogramVec(name, help string, buckets []float64, labelNames ...string) *HistogramVec {
+	if len(buckets) == 0 {
+		buckets = DefBuckets
+	}
+	v := &HistogramVec{
+		n:          name,
+		help:       help,
+		buckets:    buckets,
+		labelNames: labelNames,
+		children:   make(map[string]*histogramChild),
+	}
+	defaultRegistry.mustRegister(v)
+	return v
+}
+
+// With returns the histogram for the given label values, which must be in the
+// same order as label names. The histogram is created on first access.
+func (v *HistogramVec) With(labelValues ...string) *Histogram {
+	if len(labelValues) != len(v.labelNames) {
+		panic("metric: label values mismatch label names")
+	}
+	key := strings.Join(labelValues, "\xff")
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	child, ok := v.children[key]
+	if !ok {
+		child = &histogramChild{
+			append([]string(nil), labelValues...),
+			newHistogram(v.buckets),
+		}
+		v.children[key] = child
+	}
+	return child.h
+}
+
+func (v *HistogramVec) name() string { return v.n }
+
+func (v *HistogramVec) writeTo(w *bufio.Writer) {
+	v.lock.Lock()
+	keys := make([]string, 0, len(v.children))
+	for k := range v.children {
+		keys = append(keys, k)
+	}
+	children := v.children
+	sort.Strings(keys)
+
+	sorted := make([]*histogramChild, 0, len(keys))
+	for _, k := range keys {
+		sorted = append(sorted, children[k])
+	}
+	v.lock.Unlock()
+
+	writeHeader(w, v.n, v.help, "histogram")
+	for _, child := range sorted {
+		labels := make([]string, 0, len(v.labelNames)*2+2)
+		for i, name := range v.labelNames {
+			labels = append(labels, name, child.labelValues[i])
+		}
+		child.h.writeSamples(w, v.n, labels)
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package metric
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ContentType is the content type of prometheus text exposition format.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// collector is implemented by all metric types.
+type collector interface {
+	name() string
+	writeTo(w *bufio.Writer)
+}
+
+// Registry holds a set of metrics, and renders them in prometheus text format.
+type Registry struct {
+	lock       sync.Mutex
+	collectors map[string]collector
+}
+
+// NewRegistry creates a new empty registry.
+func NewRegistry() *Registry {
+	return &Registry{collectors: make(map[string]collector)}
+}
+
+var defaultRegistry = NewRegistry()
+
+// Default returns the default registry, which all New* functions register metrics to.
+func Default() *Registry {
+	return defaultRegistry
+}
+
+// mustRegister registers the collector. It panics if the name is already taken.
+func (r *Registry) mustRegister(c collector) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.collectors[c.name()]; ok {
+		panic(fmt.Sprintf("metric: duplicated metric name %q", c.name()))
+	}
+	r.collectors[c.name()] = c
+}
+
+// WriteTo writes all registered metrics in prometheus text format, sorted by name.
+func (r *Registry) WriteTo(w io.Writer) (int64, error) {
+	r.lock.Lock()
+	collectors := make([]collector, 0, len(r.collectors))
+	for _, c := range r.collectors {
+		collectors = append(collectors, c)
+	}
+	r.lock.Unlock()
+
+	sort.Slice(collectors, func(i, j int) bool {
+		return collectors[i].name() < collectors[j].name()
+	})
+
+	var (
+		buf bytes.Buffer
+		bw  = bufio.NewWriter(&buf)
+	)
+	for _, c := range collectors {
+		c.writeTo(bw)
+	}
+	if err := bw.Flush(); err != nil {
+		return 0, err
+	}
+	return buf.WriteTo(w)
+}
+
+// Handler returns the http handler to serve metrics of the registry.
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", ContentType)
+		if _, err := r.WriteTo(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+// Handler returns the http handler to serve metrics of the default registry.
+func Handler() http.Handler {
+	return defaultRegistry.Handler()
+}
+
+func writeHeader(w *bufio.Writer, name, help, typ string) {
+	w.WriteString("# HELP " + name + " " + escapeHelp(help) + "\n")
+	w.WriteString("# TYPE " + name + " " + typ + "\n")
+}
+
+func writeSample(w *bufio.Writer, name string, labels []string, value float64) {
+	w.WriteString(name)
+	if len(labels) > 0 {
+		w.WriteByte('{')
+		for i := 0; i+1 < len(labels); i += 2 {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			w.WriteString(labels[i] + `="` + escapeLabelValue(labels[i+1]) + `"`)
+		}
+		w.WriteByte('}')
+	}
+	w.WriteByte(' ')
+	w.WriteString(formatFloat(value))
+	w.WriteByte('\n')
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+var (
+	helpEscaper       = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelValueEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(s string) string       { return helpEscaper.Replace(s) }
+func escapeLabelValue(s string) string { return labelValueEscaper.Replace(s) }
@@ -0,0 +1,59 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package metric
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry(t *testing.T) {
+	h := NewHistogram("test_histogram", "a test histogram", []float64{1, 0.5})
+	h.Observe(0.3)
+	h.Observe(0.5)
+	h.Observe(2)
+
+	hv := NewHistogramVec("test_histogram_vec", "a test histogram vector", []float64{1}, "route")
+	hv.With(`/a"b`).Observe(1)
+
+	NewGaugeFunc("test_gauge", "a test gauge", func() float64 { return 1.5 })
+	NewCounterVecFunc("test_counter", "a test\ncounter", "kind", func() map[string]float64 {
+		return map[string]float64{"b": 2, "a": 1}
+	})
+
+	var buf bytes.Buffer
+	_, err := Default().WriteTo(&buf)
+	assert.Nil(t, err)
+
+	expected := strings.Join([]string{
+		`# HELP test_counter a test\ncounter`,
+		`# TYPE test_counter counter`,
+		`test_counter{kind="a"} 1`,
+		`test_counter{kind="b"} 2`,
+		`# HELP test_gauge a test gauge`,
+		`# TYPE test_gauge gauge`,
+		`test_gauge 1.5`,
+		`# HELP test_histogram a test histogram`,
+		`# TYPE test_histogram histogram`,
+		`test_histogram_bucket{le="0.5"} 2`,
+		`test_histogram_bucket{le="1"} 2`,
+		`test_histogram_bucket{le="+Inf"} 3`,
+		`test_histogram_sum 2.8`,
+		`test_histogram_count 3`,
+		`# HELP test_histogram_vec a test histogram vector`,
+		`# TYPE test_histogram_vec histogram`,
+		`test_histogram_vec_bucket{route="/a\"b",le="1"} 1`,
+		`test_histogram_vec_bucket{route="/a\"b",le="+Inf"} 1`,
+		`test_histogram_vec_sum{route="/a\"b"} 1`,
+		`test_histogram_vec_count{route="/a\"b"} 1`,
+	}, "\n") + "\n"
+	assert.Equal(t, expected, buf.String())
+
+	assert.Panics(t, func() { NewGaugeFunc("test_gauge", "", func() float64 { return 0 }) }, "duplicated name should panic")
+}
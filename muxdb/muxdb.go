@@ -189,6 +189,11 @@ func (db *MuxDB) LowStore() kv.Store {
 	return db.engine
 }
 
+// TrieCacheStats returns the statistics of trie node cache.
+func (db *MuxDB) TrieCacheStats() TrieCacheStats {
+	return db.trieCache.Stats()
+}
+
 // IsNotFound returns if the error indicates key not found.
 func (db *MuxDB) IsNotFound(err error) bool {
 	return db.engine.IsNotFound(err)
@@ -6,6 +6,8 @@
 package muxdb
 
 import (
+	"sync/atomic"
+
 	"github.com/coocood/freecache"
 	lru "github.com/hashicorp/golang-lru"
 )
@@ -22,6 +24,17 @@ const (
 type trieCache struct {
 	enc [trieNodeCacheSeg]*freecache.Cache // for encoded nodes
 	dec [trieNodeCacheSeg]*lru.Cache       // for decoded nodes
+
+	encHits, encMisses uint64
+	decHits, decMisses uint64
+}
+
+// TrieCacheStats is the statistics of trie node cache lookups.
+type TrieCacheStats struct {
+	EncodedHits   uint64
+	EncodedMisses uint64
+	DecodedHits   uint64
+	DecodedMisses uint64
 }
 
 func newTrieCache(encSizeMB int, decCapacity int) *trieCache {
@@ -50,6 +63,11 @@ func (c *trieCache) GetEncoded(key []byte, pathLen int, peek bool) (val []byte)
 		} else {
 			val, _ = enc.Get(key)
 		}
+		if len(val) > 0 {
+			atomic.AddUint64(&c.encHits, 1)
+		} else {
+			atomic.AddUint64(&c.encMisses, 1)
+		}
 	}
 	return
 }
@@ -75,6 +93,11 @@ func (c *trieCache) GetDecoded(key []byte, pathLen int, peek bool) (val interfac
 		} else {
 			val, _ = dec.Get(string(key))
 		}
+		if val != nil {
+			atomic.AddUint64(&c.decHits, 1)
+		} else {
+			atomic.AddUint64(&c.decMisses, 1)
+		}
 	}
 	return
 }
@@ -89,3 +112,12 @@ func (c *trieCache) SetDecoded(key []byte, val interface{}, pathLen int) {
 		dec.Add(string(key), val)
 	}
 }
+
+func (c *trieCache) Stats() TrieCacheStats {
+	return TrieCacheStats{
+		EncodedHits:   atomic.LoadUint64(&c.encHits),
+		EncodedMisses: atomic.LoadUint64(&c.encMisses),
+		DecodedHits:   atomic.LoadUint64(&c.decHits),
+		DecodedMisses: atomic.LoadUint64(&c.decMisses),
+	}
+}
@@ -59,6 +59,26 @@ func (f *Flow) TotalScore() uint64 {
 	return f.runtime.Context().TotalScore
 }
 
+// GasLimit returns gas limit of new block.
+func (f *Flow) GasLimit() uint64 {
+	return f.runtime.Context().GasLimit
+}
+
+// GasUsed returns gas used by adopted txs.
+func (f *Flow) GasUsed() uint64 {
+	return f.gasUsed
+}
+
+// Txs returns adopted txs in order.
+func (f *Flow) Txs() tx.Transactions {
+	return f.txs
+}
+
+// Receipts returns receipts of adopted txs.
+func (f *Flow) Receipts() tx.Receipts {
+	return f.receipts
+}
+
 func (f *Flow) findTx(txID thor.Bytes32) (found bool, reverted bool, err error) {
 	if reverted, ok := f.processedTxs[txID]; ok {
 		return true, reverted, nil
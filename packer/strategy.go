@@ -0,0 +1,163 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package packer
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+// names of built-in strategies.
+const (
+	StrategyGasPrice  = "gasprice"
+	StrategyFairShare = "fairshare"
+	StrategyFIFO      = "fifo"
+	StrategyKnapsack  = "knapsack"
+)
+
+// Candidate is a tx to be packed, along with the time it arrived at the pool.
+type Candidate struct {
+	Tx      *tx.Transaction
+	Arrival time.Time
+	Err     error // error of the last try to adopt the tx, set by Fill, nil if adopted or not tried
+}
+
+// Strategy chooses and orders txs to be adopted by the packing flow.
+type Strategy interface {
+	// Fill adopts candidates into the flow. Candidates are given in the order of overall gas price from high to low.
+	// It returns txs which are not adoptable forever, and should be removed from the pool.
+	// The error of adopting each candidate is recorded to Candidate.Err.
+	Fill(flow *Flow, candidates []*Candidate) (unadoptable tx.Transactions)
+}
+
+// NewStrategy creates the built-in strategy by name.
+func NewStrategy(name string) (Strategy, error) {
+	switch name {
+	case StrategyGasPrice:
+		return GasPriceStrategy{}, nil
+	case StrategyFairShare:
+		return FairShareStrategy{}, nil
+	case StrategyFIFO:
+		return FIFOStrategy{}, nil
+	case StrategyKnapsack:
+		return KnapsackStrategy{}, nil
+	default:
+		return nil, fmt.Errorf("unknown packing strategy: %s", name)
+	}
+}
+
+// filler helps adopting txs one by one, and collects unadoptable ones.
+type filler struct {
+	flow        *Flow
+	unadoptable tx.Transactions
+}
+
+// adopt adopts the tx of the candidate, and records the error to the candidate.
+// The error is returned only if the tx is not adoptable now, or the block is full.
+func (f *filler) adopt(c *Candidate) error {
+	err := f.flow.Adopt(c.Tx)
+	c.Err = err
+	if err != nil && !IsGasLimitReached(err) && !IsTxNotAdoptableNow(err) {
+		f.unadoptable = append(f.unadoptable, c.Tx)
+		return nil
+	}
+	return err
+}
+
+// fillInOrder adopts candidates in order until the block is full.
+func fillInOrder(flow *Flow, candidates []*Candidate) tx.Transactions {
+	f := &filler{flow: flow}
+	for _, c := range candidates {
+		if IsGasLimitReached(f.adopt(c)) {
+			break
+		}
+	}
+	return f.unadoptable
+}
+
+// GasPriceStrategy adopts txs with higher overall gas price first.
+type GasPriceStrategy struct{}
+
+// Fill implements Strategy.
+func (GasPriceStrategy) Fill(flow *Flow, candidates []*Candidate) tx.Transactions {
+	return fillInOrder(flow, candidates)
+}
+
+// FairShareStrategy adopts txs from each origin in turns, so that an origin can't take up the block.
+// Txs of the same origin are adopted in the order of overall gas price.
+type FairShareStrategy struct{}
+
+// Fill implements Strategy.
+func (FairShareStrategy) Fill(flow *Flow, candidates []*Candidate) tx.Transactions {
+	var (
+		origins []thor.Address
+		queues  = make(map[thor.Address][]*Candidate)
+	)
+	for _, c := range candidates {
+		origin, _ := c.Tx.Origin()
+		if _, ok := queues[origin]; !ok {
+			origins = append(origins, origin)
+		}
+		queues[origin] = append(queues[origin], c)
+	}
+
+	ordered := make([]*Candidate, 0, len(candidates))
+	for round := 0; len(ordered) < len(candidates); round++ {
+		for _, origin := range origins {
+			if q := queues[origin]; round < len(q) {
+				ordered = append(ordered, q[round])
+			}
+		}
+	}
+	return fillInOrder(flow, ordered)
+}
+
+// FIFOStrategy adopts txs in the order of arrival, which suits permissioned networks where gas price is not concerned.
+type FIFOStrategy struct{}
+
+// Fill implements Strategy.
+func (FIFOStrategy) Fill(flow *Flow, candidates []*Candidate) tx.Transactions {
+	ordered := append([]*Candidate(nil), candidates...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Arrival.Before(ordered[j].Arrival)
+	})
+	return fillInOrder(flow, ordered)
+}
+
+// KnapsackStrategy adopts txs like GasPriceStrategy at first. Then the rest space is filled with
+// txs not tried or deferred, from the smallest, including those become adoptable as their dependencies adopted.
+type KnapsackStrategy struct{}
+
+// Fill implements Strategy.
+func (KnapsackStrategy) Fill(flow *Flow, candidates []*Candidate) tx.Transactions {
+	f := &filler{flow: flow}
+	var rest []*Candidate
+	for i, c := range candidates {
+		err := f.adopt(c)
+		if IsGasLimitReached(err) {
+			rest = append(rest, candidates[i+1:]...)
+			break
+		}
+		if IsTxNotAdoptableNow(err) {
+			rest = append(rest, c)
+		}
+	}
+
+	sort.SliceStable(rest, func(i, j int) bool {
+		return rest[i].Tx.Gas() < rest[j].Tx.Gas()
+	})
+	for _, c := range rest {
+		if IsGasLimitReached(f.adopt(c)) {
+			// no space for larger txs
+			break
+		}
+	}
+	return f.unadoptable
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package packer_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/packer"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestNewStrategy(t *testing.T) {
+	for _, name := range []string{packer.StrategyGasPrice, packer.StrategyFairShare, packer.StrategyFIFO, packer.StrategyKnapsack} {
+		s, err := packer.NewStrategy(name)
+		assert.Nil(t, err)
+		assert.NotNil(t, s)
+	}
+	_, err := packer.NewStrategy("unknown")
+	assert.NotNil(t, err)
+}
+
+func TestStrategies(t *testing.T) {
+	db := muxdb.NewMem()
+	b0, _, _, _ := genesis.NewDevnet().Build(state.NewStater(db))
+	repo, _ := chain.NewRepository(db, b0)
+	master := genesis.DevAccounts()[0]
+	p := packer.New(repo, state.NewStater(db), master.Address, &master.Address, thor.NoFork)
+
+	newTx := func(acc genesis.DevAccount, dependsOn *thor.Bytes32) *tx.Transaction {
+		trx := new(tx.Builder).
+			ChainTag(repo.ChainTag()).
+			Clause(tx.NewClause(&acc.Address)).
+			Gas(21000).
+			Expiration(100).
+			DependsOn(dependsOn).
+			Nonce(nonce).
+			Build()
+		nonce++
+		sig, _ := crypto.Sign(trx.SigningHash().Bytes(), acc.PrivateKey)
+		return trx.WithSignature(sig)
+	}
+	fill := func(s packer.Strategy, gasLimit uint64, candidates []*packer.Candidate) (tx.Transactions, tx.Transactions) {
+		flow, err := p.Mock(b0.Header(), b0.Header().Timestamp()+thor.BlockInterval, gasLimit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		unadoptable := s.Fill(flow, candidates)
+		blk, _, _, err := flow.Pack(master.PrivateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return blk.Transactions(), unadoptable
+	}
+
+	accs := genesis.DevAccounts()
+	now := time.Now()
+	a1, a2, a3 := newTx(accs[0], nil), newTx(accs[0], nil), newTx(accs[1], nil)
+	candidates := []*packer.Candidate{
+		{Tx: a1, Arrival: now.Add(2 * time.Second)},
+		{Tx: a2, Arrival: now.Add(time.Second)},
+		{Tx: a3, Arrival: now},
+	}
+
+	// room for 2 txs
+	txs, _ := fill(packer.GasPriceStrategy{}, 42000, candidates)
+	assert.Equal(t, tx.Transactions{a1, a2}, txs)
+
+	txs, _ = fill(packer.FairShareStrategy{}, 42000, candidates)
+	assert.Equal(t, tx.Transactions{a1, a3}, txs)
+
+	txs, _ = fill(packer.FIFOStrategy{}, 42000, candidates)
+	assert.Equal(t, tx.Transactions{a3, a2}, txs)
+
+	// dependent tx comes first
+	dep := newTx(accs[2], nil)
+	depID := dep.ID()
+	child := newTx(accs[2], &depID)
+	candidates = []*packer.Candidate{{Tx: child}, {Tx: dep}}
+
+	txs, _ = fill(packer.GasPriceStrategy{}, 0, candidates)
+	assert.Equal(t, tx.Transactions{dep}, txs)
+	assert.True(t, packer.IsTxNotAdoptableNow(candidates[0].Err))
+	assert.Nil(t, candidates[1].Err)
+
+	txs, _ = fill(packer.KnapsackStrategy{}, 0, candidates)
+	assert.Equal(t, tx.Transactions{dep, child}, txs)
+	assert.Nil(t, candidates[0].Err)
+
+	// bad tx
+	bad := new(tx.Builder).ChainTag(repo.ChainTag() + 1).Gas(21000).Build()
+	sig, _ := crypto.Sign(bad.SigningHash().Bytes(), accs[3].PrivateKey)
+	bad = bad.WithSignature(sig)
+	candidates = []*packer.Candidate{{Tx: bad}}
+	txs, unadoptable := fill(packer.GasPriceStrategy{}, 0, candidates)
+	assert.Equal(t, 0, len(txs))
+	assert.Equal(t, tx.Transactions{bad}, unadoptable)
+	assert.True(t, packer.IsBadTx(candidates[0].Err))
+}
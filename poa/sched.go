@@ -54,7 +54,8 @@ func NewScheduler(
 	}, nil
 }
 
-func (s *Scheduler) whoseTurn(t uint64) Proposer {
+// WhoseTurn returns the proposer whose turn it is to produce a block at time t.
+func (s *Scheduler) WhoseTurn(t uint64) Proposer {
 	index := dprp(s.parentBlockNumber, t) % uint64(len(s.actives))
 	return s.actives[index]
 }
@@ -72,7 +73,7 @@ func (s *Scheduler) Schedule(nowTime uint64) (newBlockTime uint64) {
 	}
 
 	for {
-		p := s.whoseTurn(newBlockTime)
+		p := s.WhoseTurn(newBlockTime)
 		if p.Address == s.proposer.Address {
 			return newBlockTime
 		}
@@ -94,7 +95,7 @@ func (s *Scheduler) IsTheTime(newBlockTime uint64) bool {
 		return false
 	}
 
-	return s.whoseTurn(newBlockTime).Address == s.proposer.Address
+	return s.WhoseTurn(newBlockTime).Address == s.proposer.Address
 }
 
 // Updates returns proposers whose status are change, and the score when new block time is assumed to be newBlockTime.
@@ -104,7 +105,7 @@ func (s *Scheduler) Updates(newBlockTime uint64) (updates []Proposer, score uint
 
 	t := newBlockTime - thor.BlockInterval
 	for i := uint64(0); i < thor.MaxBlockProposers && t > s.parentBlockTime; i++ {
-		p := s.whoseTurn(t)
+		p := s.WhoseTurn(t)
 		if p.Address != s.proposer.Address {
 			toDeactivate[p.Address] = p
 		}
@@ -46,6 +46,17 @@ func TestSchedule(t *testing.T) {
 	}
 }
 
+func TestWhoseTurn(t *testing.T) {
+	sched, _ := poa.NewScheduler(p1, proposers, 1, parentTime)
+
+	for i := uint64(1); i < 100; i++ {
+		newBlockTime := parentTime + i*thor.BlockInterval
+		p := sched.WhoseTurn(newBlockTime)
+		assert.True(t, p.Address == p1 || p.Address == p2, "only active ones and the scheduled one")
+		assert.Equal(t, p.Address == p1, sched.IsTheTime(newBlockTime))
+	}
+}
+
 func TestIsTheTime(t *testing.T) {
 	sched, _ := poa.NewScheduler(p2, proposers, 1, parentTime)
 
@@ -0,0 +1,133 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package reorg keeps a journal of chain reorganizations.
+package reorg
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/co"
+	"github.com/vechain/thor/kv"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/thor"
+)
+
+const (
+	storeName = "reorg.journal"
+	// max count of reorgs kept in the journal.
+	maxEntries = 10000
+)
+
+// Reorg describes a chain reorganization, that the trunk switched from OldHead to NewHead.
+type Reorg struct {
+	Seq            uint64         // sequence number in the journal, starts from 0
+	Timestamp      uint64         // unix timestamp when it happened
+	OldHead        thor.Bytes32   // the best block before
+	NewHead        thor.Bytes32   // the best block after
+	CommonAncestor thor.Bytes32   // the latest block shared by both
+	Orphaned       []thor.Bytes32 // blocks of the old trunk after the common ancestor, in ascending order
+}
+
+// Journal persists reorgs, and notifies waiters.
+type Journal struct {
+	store   kv.Store
+	lock    sync.Mutex
+	nextSeq uint64
+	tick    co.Signal
+}
+
+// New creates a journal, which is stored in db.
+func New(db *muxdb.MuxDB) (*Journal, error) {
+	j := &Journal{store: db.NewStore(storeName)}
+
+	// find the next seq
+	var last []byte
+	if err := j.store.Iterate(kv.Range{}, func(pair kv.Pair) bool {
+		last = append(last[:0], pair.Key()...)
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	if len(last) == 8 {
+		j.nextSeq = binary.BigEndian.Uint64(last) + 1
+	}
+	return j, nil
+}
+
+// Record assigns the seq and saves the reorg, and notifies waiters without blocking.
+func (j *Journal) Record(r *Reorg) error {
+	if err := j.save(r); err != nil {
+		return err
+	}
+	j.tick.Broadcast()
+	return nil
+}
+
+func (j *Journal) save(r *Reorg) error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	r.Seq = j.nextSeq
+	data, err := rlp.EncodeToBytes(r)
+	if err != nil {
+		return err
+	}
+	if err := j.store.Batch(func(w kv.PutFlusher) error {
+		if r.Seq >= maxEntries {
+			if err := w.Delete(seqKey(r.Seq - maxEntries)); err != nil {
+				return err
+			}
+		}
+		return w.Put(seqKey(r.Seq), data)
+	}); err != nil {
+		return err
+	}
+	j.nextSeq++
+	return nil
+}
+
+// Range returns at most limit reorgs with seq not less than from, in ascending order of seq.
+func (j *Journal) Range(from uint64, limit int) ([]*Reorg, error) {
+	reorgs := []*Reorg{}
+	var decodeErr error
+	if err := j.store.Iterate(kv.Range{Start: seqKey(from)}, func(pair kv.Pair) bool {
+		if len(reorgs) >= limit {
+			return false
+		}
+		var r Reorg
+		if decodeErr = rlp.DecodeBytes(pair.Value(), &r); decodeErr != nil {
+			return false
+		}
+		reorgs = append(reorgs, &r)
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	if decodeErr != nil {
+		return nil, decodeErr
+	}
+	return reorgs, nil
+}
+
+// NextSeq returns the seq to be assigned to the next reorg.
+func (j *Journal) NextSeq() uint64 {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	return j.nextSeq
+}
+
+// NewTicker create a signal Waiter to receive event that new reorgs recorded.
+func (j *Journal) NewTicker() co.Waiter {
+	return j.tick.NewWaiter()
+}
+
+func seqKey(seq uint64) []byte {
+	var k [8]byte
+	binary.BigEndian.PutUint64(k[:], seq)
+	return k[:]
+}
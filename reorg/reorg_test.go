@@ -0,0 +1,60 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package reorg_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/reorg"
+	"github.com/vechain/thor/thor"
+)
+
+func TestJournal(t *testing.T) {
+	db := muxdb.NewMem()
+	j, err := reorg.New(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, uint64(0), j.NextSeq())
+
+	ticker := j.NewTicker()
+
+	r0 := &reorg.Reorg{
+		Timestamp:      1,
+		OldHead:        thor.BytesToBytes32([]byte("old")),
+		NewHead:        thor.BytesToBytes32([]byte("new")),
+		CommonAncestor: thor.BytesToBytes32([]byte("ancestor")),
+		Orphaned:       []thor.Bytes32{thor.BytesToBytes32([]byte("old"))},
+	}
+	r1 := &reorg.Reorg{Timestamp: 2, Orphaned: []thor.Bytes32{}}
+	assert.Nil(t, j.Record(r0))
+	assert.Nil(t, j.Record(r1))
+	assert.Equal(t, uint64(1), r1.Seq)
+	select {
+	case <-ticker.C():
+	default:
+		t.Fatal("should be notified")
+	}
+
+	reorgs, err := j.Range(0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []*reorg.Reorg{r0, r1}, reorgs)
+
+	reorgs, err = j.Range(1, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []*reorg.Reorg{r1}, reorgs)
+
+	reorgs, err = j.Range(0, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, []*reorg.Reorg{r0}, reorgs)
+
+	// reopened
+	j, err = reorg.New(db)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), j.NextSeq())
+}
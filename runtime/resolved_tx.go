@@ -17,6 +17,9 @@ import (
 	"github.com/vechain/thor/xenv"
 )
 
+// ErrInsufficientEnergy is returned by BuyGas if the gas payer can't afford the gas.
+var ErrInsufficientEnergy = errors.New("insufficient energy")
+
 // ResolvedTransaction resolve the transaction according to given state.
 type ResolvedTransaction struct {
 	tx           *tx.Transaction
@@ -125,7 +128,7 @@ func (r *ResolvedTransaction) BuyGas(state *state.State, blockTime uint64) (
 				return err
 			}, nil
 		}
-		return nil, nil, thor.Address{}, nil, errors.New("insufficient energy")
+		return nil, nil, thor.Address{}, nil, ErrInsufficientEnergy
 	}
 
 	commonTo := r.CommonTo()
@@ -188,7 +191,7 @@ func (r *ResolvedTransaction) BuyGas(state *state.State, blockTime uint64) (
 	if sufficient {
 		return baseGasPrice, gasPrice, r.Origin, func(rgas uint64) error { _, err := doReturnGas(rgas); return err }, nil
 	}
-	return nil, nil, thor.Address{}, nil, errors.New("insufficient energy")
+	return nil, nil, thor.Address{}, nil, ErrInsufficientEnergy
 }
 
 // ToContext create a tx context object.
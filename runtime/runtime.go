@@ -366,6 +366,7 @@ func (rt *Runtime) PrepareTransaction(tx *tx.Transaction) (*TransactionExecutor,
 
 	txOutputs := make([]*Tx.Output, 0, len(resolvedTx.Clauses))
 	reverted := false
+	var revertData []byte
 	finalized := false
 
 	hasNext := func() bool {
@@ -398,6 +399,7 @@ func (rt *Runtime) PrepareTransaction(tx *tx.Transaction) (*TransactionExecutor,
 				// revert all executed clauses
 				rt.state.RevertTo(checkpoint)
 				reverted = true
+				revertData = output.Data
 				txOutputs = nil
 				return
 			}
@@ -414,10 +416,11 @@ func (rt *Runtime) PrepareTransaction(tx *tx.Transaction) (*TransactionExecutor,
 			finalized = true
 
 			receipt := &Tx.Receipt{
-				Reverted: reverted,
-				Outputs:  txOutputs,
-				GasUsed:  tx.Gas() - leftOverGas,
-				GasPayer: payer,
+				Reverted:   reverted,
+				Outputs:    txOutputs,
+				RevertData: revertData,
+				GasUsed:    tx.Gas() - leftOverGas,
+				GasPayer:   payer,
 			}
 
 			receipt.Paid = new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), gasPrice)
@@ -0,0 +1,454 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package snapshot exports and imports state snapshots, so that a node can start syncing from a recent block
+// instead of replaying all blocks from genesis.
+//
+// A snapshot is an RLP stream, which consists of, in order:
+//  - the header
+//  - leaves of the index trie of the first recent block, ended by an empty entry
+//  - accounts of the state of the snapshot block, each followed by leaves of its storage trie and an empty entry,
+//    ended by an empty entry
+//  - contract codes, ended by an empty entry
+//  - recent blocks with receipts in ascending order, ending with the snapshot block
+//
+// Tries are rebuilt from leaves on import. State tries and recent blocks are verified against the expected
+// snapshot block, which should be obtained from a trusted source.
+//
+// The index trie, which maps block numbers to IDs and tx IDs to tx metas of blocks before the recent ones,
+// is NOT verifiable. Its root is taken from the header of the snapshot, and it's only checked to contain the
+// first recent block. So a forged snapshot can inject tx metas, and the snapshot itself must be obtained
+// from a trusted source.
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/inconshreveable/log15"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/trie"
+	"github.com/vechain/thor/tx"
+)
+
+var log = log15.New("pkg", "snapshot")
+
+const (
+	// Version the version of snapshot format.
+	Version = uint32(1)
+
+	// count of leaves to be inserted before trie committed, to limit memory usage.
+	commitInterval = 10000
+	// count of accounts between progress logs.
+	logInterval = 100000
+)
+
+// Header the header of snapshot.
+type Header struct {
+	Version   uint32
+	GenesisID thor.Bytes32
+	BlockID   thor.Bytes32 // the block whose state is taken
+	Blocks    uint32       // count of recent blocks included, ending with the snapshot block
+	IndexRoot thor.Bytes32 // root of the index trie of the first included block, not verifiable against the snapshot block
+}
+
+// blockEntry a block with its receipts.
+// Revert data of receipts is not included, since it's not part of consensus.
+type blockEntry struct {
+	Block    *block.Block
+	Receipts tx.Receipts
+}
+
+// entry a trie leaf, or a code. For secure tries, the key is the preimage of the hashed key.
+// An entry with empty key marks the end of a section.
+type entry struct {
+	Key   []byte
+	Value []byte
+}
+
+// Export writes the snapshot of the state of the given block, along with count of recent blocks ending with it.
+// The state of the block should not be pruned.
+func Export(ctx context.Context, w io.Writer, db *muxdb.MuxDB, repo *chain.Repository, blockID thor.Bytes32, blocks uint32) error {
+	if blocks == 0 {
+		return errors.New("at least 1 block required")
+	}
+	summary, err := repo.GetBlockSummary(blockID)
+	if err != nil {
+		return errors.Wrap(err, "get snapshot block")
+	}
+	if n := summary.Header.Number() + 1; blocks > n {
+		blocks = n
+	}
+
+	snapshotChain := repo.NewChain(blockID)
+	firstID, err := snapshotChain.GetBlockID(summary.Header.Number() + 1 - blocks)
+	if err != nil {
+		return errors.Wrap(err, "get first block")
+	}
+	first, err := repo.GetBlockSummary(firstID)
+	if err != nil {
+		return errors.Wrap(err, "get first block")
+	}
+
+	if err := rlp.Encode(w, &Header{
+		Version:   Version,
+		GenesisID: repo.GenesisBlock().Header().ID(),
+		BlockID:   blockID,
+		Blocks:    blocks,
+		IndexRoot: first.IndexRoot,
+	}); err != nil {
+		return err
+	}
+
+	// index trie
+	log.Info("exporting index trie...")
+	if _, err := exportTrie(ctx, w, db.NewTrie(chain.IndexTrieName, first.IndexRoot), false); err != nil {
+		return errors.Wrap(err, "export index trie")
+	}
+
+	// accounts and storage tries
+	log.Info("exporting accounts...")
+	codeHashes := make(map[thor.Bytes32]bool)
+	accountTrie := db.NewSecureTrie(state.AccountTrieName, summary.Header.StateRoot())
+	var count int
+	if err := iterateTrie(ctx, accountTrie, true, func(key, value []byte) error {
+		var acc state.Account
+		if err := rlp.DecodeBytes(value, &acc); err != nil {
+			return err
+		}
+		if err := rlp.Encode(w, &entry{key, value}); err != nil {
+			return err
+		}
+		if len(acc.StorageRoot) > 0 {
+			storageTrie := db.NewSecureTrie(
+				state.StorageTrieName(thor.Blake2b(key)),
+				thor.BytesToBytes32(acc.StorageRoot))
+			if _, err := exportTrie(ctx, w, storageTrie, true); err != nil {
+				return errors.WithMessage(err, fmt.Sprintf("export storage of %v", thor.BytesToAddress(key)))
+			}
+		} else if err := rlp.Encode(w, &entry{}); err != nil {
+			return err
+		}
+		if len(acc.CodeHash) > 0 {
+			codeHashes[thor.BytesToBytes32(acc.CodeHash)] = true
+		}
+
+		count++
+		if count%logInterval == 0 {
+			log.Info("exported accounts", "count", count)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "export accounts")
+	}
+	if err := rlp.Encode(w, &entry{}); err != nil {
+		return err
+	}
+
+	// codes
+	log.Info("exporting codes...", "accounts", count)
+	codeStore := db.NewStore(state.CodeStoreName)
+	for hash := range codeHashes {
+		code, err := codeStore.Get(hash[:])
+		if err != nil {
+			return errors.Wrap(err, "get code")
+		}
+		if err := rlp.Encode(w, &entry{hash.Bytes(), code}); err != nil {
+			return err
+		}
+	}
+	if err := rlp.Encode(w, &entry{}); err != nil {
+		return err
+	}
+
+	// blocks
+	log.Info("exporting blocks...", "count", blocks)
+	for n := first.Header.Number(); n <= summary.Header.Number(); n++ {
+		b, err := snapshotChain.GetBlock(n)
+		if err != nil {
+			return errors.Wrap(err, "get block")
+		}
+		receipts, err := repo.GetBlockReceipts(b.Header().ID())
+		if err != nil {
+			return errors.Wrap(err, "get block receipts")
+		}
+		if err := rlp.Encode(w, &blockEntry{b, receipts}); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+	}
+	return nil
+}
+
+// Import restores the snapshot of the expected block into the repository, whose best block should be genesis.
+// The index trie is trusted as is, see the package doc.
+// The best block is left unchanged, and it's up to the caller to set it to the snapshot block once
+// everything depending on the restored blocks is done. The header of the snapshot is returned on success.
+func Import(ctx context.Context, r io.Reader, db *muxdb.MuxDB, repo *chain.Repository, blockID thor.Bytes32) (*Header, error) {
+	if repo.BestBlock().Header().Number() != 0 {
+		return nil, errors.New("chain not empty")
+	}
+	s := rlp.NewStream(r, 0)
+
+	var header Header
+	if err := s.Decode(&header); err != nil {
+		return nil, errors.Wrap(err, "decode header")
+	}
+	if header.Version != Version {
+		return nil, fmt.Errorf("unsupported version %v", header.Version)
+	}
+	if header.GenesisID != repo.GenesisBlock().Header().ID() {
+		return nil, errors.New("genesis mismatch")
+	}
+	if header.BlockID != blockID {
+		return nil, fmt.Errorf("snapshot block mismatch, want %v got %v", blockID, header.BlockID)
+	}
+	if header.Blocks == 0 {
+		return nil, errors.New("no block")
+	}
+
+	// index trie
+	log.Info("importing index trie...")
+	if root, _, err := importTrie(ctx, s, newTrieBuilder(db, chain.IndexTrieName, false)); err != nil {
+		return nil, errors.Wrap(err, "import index trie")
+	} else if root != header.IndexRoot {
+		return nil, errors.New("index root mismatch")
+	}
+
+	// accounts and storage tries
+	log.Info("importing accounts...")
+	codeHashes := make(map[thor.Bytes32]bool)
+	accounts := newTrieBuilder(db, state.AccountTrieName, true)
+	var count int
+	for {
+		var e entry
+		if err := s.Decode(&e); err != nil {
+			return nil, errors.Wrap(err, "decode account")
+		}
+		if len(e.Key) == 0 {
+			break
+		}
+		var acc state.Account
+		if err := rlp.DecodeBytes(e.Value, &acc); err != nil {
+			return nil, errors.Wrap(err, "decode account")
+		}
+		root, n, err := importTrie(ctx, s, newTrieBuilder(db, state.StorageTrieName(thor.Blake2b(e.Key)), true))
+		if err != nil {
+			return nil, errors.WithMessage(err, fmt.Sprintf("import storage of %v", thor.BytesToAddress(e.Key)))
+		}
+		if (n > 0 || len(acc.StorageRoot) > 0) && root != thor.BytesToBytes32(acc.StorageRoot) {
+			return nil, fmt.Errorf("storage root mismatch of %v", thor.BytesToAddress(e.Key))
+		}
+		if len(acc.CodeHash) > 0 {
+			codeHashes[thor.BytesToBytes32(acc.CodeHash)] = true
+		}
+		if err := accounts.update(e.Key, e.Value); err != nil {
+			return nil, err
+		}
+
+		count++
+		if count%logInterval == 0 {
+			log.Info("imported accounts", "count", count)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+	}
+	stateRoot, err := accounts.commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// codes
+	log.Info("importing codes...", "accounts", count)
+	codeStore := db.NewStore(state.CodeStoreName)
+	for {
+		var e entry
+		if err := s.Decode(&e); err != nil {
+			return nil, errors.Wrap(err, "decode code")
+		}
+		if len(e.Key) == 0 {
+			break
+		}
+		hash := thor.BytesToBytes32(e.Key)
+		if thor.BytesToBytes32(crypto.Keccak256(e.Value)) != hash {
+			return nil, fmt.Errorf("code hash mismatch %v", hash)
+		}
+		if err := codeStore.Put(hash[:], e.Value); err != nil {
+			return nil, err
+		}
+		delete(codeHashes, hash)
+	}
+	if len(codeHashes) > 0 {
+		return nil, fmt.Errorf("%v codes missing", len(codeHashes))
+	}
+
+	// blocks are verified by hashes and links, and the last one must be the snapshot block
+	log.Info("importing blocks...", "count", header.Blocks)
+	var parent *block.Header
+	for i := uint32(0); i < header.Blocks; i++ {
+		var e blockEntry
+		if err := s.Decode(&e); err != nil {
+			return nil, errors.Wrap(err, "decode block")
+		}
+		h := e.Block.Header()
+		if h.TxsRoot() != e.Block.Transactions().RootHash() {
+			return nil, fmt.Errorf("block %v: txs root mismatch", h.Number())
+		}
+		if h.ReceiptsRoot() != e.Receipts.RootHash() {
+			return nil, fmt.Errorf("block %v: receipts root mismatch", h.Number())
+		}
+		if parent == nil {
+			// the first block, whose index trie is restored
+			if err := repo.RestoreBlock(e.Block, e.Receipts, header.IndexRoot); err != nil {
+				return nil, errors.Wrap(err, "restore block")
+			}
+			id, err := repo.NewChain(h.ID()).GetBlockID(h.Number())
+			if err != nil {
+				return nil, errors.Wrap(err, "get block id")
+			}
+			if id != h.ID() {
+				return nil, errors.New("index trie mismatch")
+			}
+		} else {
+			if h.ParentID() != parent.ID() {
+				return nil, fmt.Errorf("block %v: not linked", h.Number())
+			}
+			if err := repo.AddBlock(e.Block, e.Receipts); err != nil {
+				return nil, errors.Wrap(err, "add block")
+			}
+		}
+		parent = h
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+	}
+	if parent.ID() != header.BlockID {
+		return nil, errors.New("snapshot block mismatch")
+	}
+	if parent.StateRoot() != stateRoot {
+		return nil, errors.New("state root mismatch")
+	}
+	return &header, nil
+}
+
+// iterateTrie iterates leaves of the trie. For secure tries, keys are preimages of hashed keys.
+func iterateTrie(ctx context.Context, t *muxdb.Trie, secure bool, fn func(key, value []byte) error) error {
+	it := trie.NewIterator(t.NodeIterator(nil))
+	for i := 0; it.Next(); i++ {
+		key := it.Key
+		if secure {
+			if key = t.GetKeyPreimage(thor.BytesToBytes32(it.Key)); len(key) == 0 {
+				return fmt.Errorf("missing preimage of key %x", it.Key)
+			}
+		}
+		if err := fn(key, it.Value); err != nil {
+			return err
+		}
+		if i%commitInterval == 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+		}
+	}
+	return it.Err
+}
+
+// exportTrie writes leaves of the trie, and an empty entry to end.
+func exportTrie(ctx context.Context, w io.Writer, t *muxdb.Trie, secure bool) (count int, err error) {
+	if err := iterateTrie(ctx, t, secure, func(key, value []byte) error {
+		count++
+		return rlp.Encode(w, &entry{key, value})
+	}); err != nil {
+		return 0, err
+	}
+	return count, rlp.Encode(w, &entry{})
+}
+
+// importTrie reads leaves until an empty entry, and returns the root of the built trie.
+func importTrie(ctx context.Context, s *rlp.Stream, b *trieBuilder) (root thor.Bytes32, count int, err error) {
+	for {
+		var e entry
+		if err := s.Decode(&e); err != nil {
+			return thor.Bytes32{}, 0, errors.Wrap(err, "decode entry")
+		}
+		if len(e.Key) == 0 {
+			break
+		}
+		if err := b.update(e.Key, e.Value); err != nil {
+			return thor.Bytes32{}, 0, err
+		}
+		count++
+		if count%commitInterval == 0 {
+			select {
+			case <-ctx.Done():
+				return thor.Bytes32{}, 0, ctx.Err()
+			default:
+			}
+		}
+	}
+	root, err = b.commit()
+	return root, count, err
+}
+
+// trieBuilder builds a trie by inserting leaves, and commits periodically to limit memory usage.
+type trieBuilder struct {
+	db     *muxdb.MuxDB
+	name   string
+	secure bool
+	trie   *muxdb.Trie
+	count  int
+}
+
+func newTrieBuilder(db *muxdb.MuxDB, name string, secure bool) *trieBuilder {
+	b := &trieBuilder{db: db, name: name, secure: secure}
+	b.trie = b.newTrie(thor.Bytes32{})
+	return b
+}
+
+func (b *trieBuilder) newTrie(root thor.Bytes32) *muxdb.Trie {
+	if b.secure {
+		return b.db.NewSecureTrie(b.name, root)
+	}
+	return b.db.NewTrie(b.name, root)
+}
+
+func (b *trieBuilder) update(key, value []byte) error {
+	if err := b.trie.Update(key, value); err != nil {
+		return err
+	}
+	b.count++
+	if b.count%commitInterval == 0 {
+		root, err := b.trie.Commit()
+		if err != nil {
+			return err
+		}
+		// drop nodes held in memory
+		b.trie = b.newTrie(root)
+	}
+	return nil
+}
+
+func (b *trieBuilder) commit() (thor.Bytes32, error) {
+	return b.trie.Commit()
+}
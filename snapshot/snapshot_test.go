@@ -0,0 +1,105 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package snapshot_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/snapshot"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/testchain"
+	"github.com/vechain/thor/thor"
+)
+
+func TestSnapshot(t *testing.T) {
+	c, err := testchain.NewDevnet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, stater, repo := c.DB(), c.Stater(), c.Repo()
+	b0 := repo.GenesisBlock()
+	newBlock := func(parent *block.Header) *block.Header {
+		blk, err := c.NewBlock(parent, parent.Timestamp()+thor.BlockInterval)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return blk.Header()
+	}
+	b1 := newBlock(b0.Header())
+	b2 := newBlock(b1)
+	b3 := newBlock(b2)
+	repo.SetBestBlockID(b3.ID())
+
+	var buf bytes.Buffer
+	assert.Nil(t, snapshot.Export(context.Background(), &buf, db, repo, b3.ID(), 2))
+	data := buf.Bytes()
+
+	newRepo := func() (*muxdb.MuxDB, *chain.Repository) {
+		db := muxdb.NewMem()
+		b0, _, _, _ := genesis.NewDevnet().Build(state.NewStater(db))
+		repo, _ := chain.NewRepository(db, b0)
+		return db, repo
+	}
+
+	db2, repo2 := newRepo()
+	header, err := snapshot.Import(context.Background(), bytes.NewReader(data), db2, repo2, b3.ID())
+	assert.Nil(t, err)
+	assert.Equal(t, b3.ID(), header.BlockID)
+	assert.Equal(t, uint32(2), header.Blocks)
+	assert.Equal(t, b0.Header().ID(), repo2.BestBlock().Header().ID(), "best block left to the caller")
+
+	// index of blocks before the snapshot
+	id, err := repo2.NewChain(b3.ID()).GetBlockID(1)
+	assert.Nil(t, err)
+	assert.Equal(t, b1.ID(), id)
+	_, err = repo2.GetBlockSummary(b2.ID())
+	assert.Nil(t, err)
+
+	// state
+	st1 := stater.NewState(b3.StateRoot())
+	st2 := state.NewStater(db2).NewState(b3.StateRoot())
+	for _, acc := range genesis.DevAccounts() {
+		want, _ := st1.GetBalance(acc.Address)
+		got, err := st2.GetBalance(acc.Address)
+		assert.Nil(t, err)
+		assert.Equal(t, want, got)
+	}
+	want, _ := st1.GetCode(builtin.Params.Address)
+	got, err := st2.GetCode(builtin.Params.Address)
+	assert.Nil(t, err)
+	assert.Equal(t, want, got)
+	assert.NotEmpty(t, got)
+	wantPrice, _ := builtin.Params.Native(st1).Get(thor.KeyBaseGasPrice)
+	gotPrice, err := builtin.Params.Native(st2).Get(thor.KeyBaseGasPrice)
+	assert.Nil(t, err)
+	assert.Equal(t, wantPrice, gotPrice)
+
+	// not empty
+	assert.Nil(t, repo2.SetBestBlockID(b3.ID()))
+	_, err = snapshot.Import(context.Background(), bytes.NewReader(data), db2, repo2, b3.ID())
+	assert.NotNil(t, err)
+
+	// unexpected block
+	db3, repo3 := newRepo()
+	_, err = snapshot.Import(context.Background(), bytes.NewReader(data), db3, repo3, b2.ID())
+	assert.NotNil(t, err)
+
+	// corrupted
+	corrupted := append([]byte(nil), data...)
+	corrupted[len(corrupted)-100]++
+	db4, repo4 := newRepo()
+	_, err = snapshot.Import(context.Background(), bytes.NewReader(corrupted), db4, repo4, b3.ID())
+	assert.NotNil(t, err)
+	assert.Equal(t, b0.Header().ID(), repo4.BestBlock().Header().ID(), "best block unchanged")
+}
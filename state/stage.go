@@ -26,7 +26,7 @@ func (s *Stage) Hash() thor.Bytes32 {
 
 // Commit commits all changes into main accounts trie and storage tries.
 func (s *Stage) Commit() (thor.Bytes32, error) {
-	codeStore := s.db.NewStore(codeStoreName)
+	codeStore := s.db.NewStore(CodeStoreName)
 
 	// write codes
 	if err := codeStore.Batch(func(w kv.PutFlusher) error {
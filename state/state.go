@@ -21,7 +21,8 @@ const (
 	// AccountTrieName is the name of account trie.
 	AccountTrieName = "a"
 
-	codeStoreName = "state.code"
+	// CodeStoreName is the name of kv-store of contract codes, which are keyed by code hash.
+	CodeStoreName = "state.code"
 )
 
 // StorageTrieName returns the name of storage trie.
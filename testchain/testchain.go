@@ -0,0 +1,169 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package testchain provides chains whose blocks are produced on demand by dev accounts as authorities.
+// It's intended for tests only.
+package testchain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/packer"
+	"github.com/vechain/thor/poa"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+)
+
+// Chain a chain whose authorities are controlled.
+type Chain struct {
+	db          *muxdb.MuxDB
+	stater      *state.Stater
+	repo        *chain.Repository
+	authorities []genesis.DevAccount
+}
+
+// New wraps the repository, whose authority nodes are the given accounts.
+func New(db *muxdb.MuxDB, repo *chain.Repository, authorities []genesis.DevAccount) *Chain {
+	return &Chain{
+		db,
+		state.NewStater(db),
+		repo,
+		authorities,
+	}
+}
+
+// NewDevnet creates a chain of devnet in memory, whose only authority is the first dev account.
+func NewDevnet() (*Chain, error) {
+	return newChain(genesis.NewDevnet(), genesis.DevAccounts()[:1])
+}
+
+// NewCustomNet creates a chain in memory upon the genesis described by GenesisJSON.
+func NewCustomNet(authorities int) (*Chain, error) {
+	data, err := GenesisJSON(authorities)
+	if err != nil {
+		return nil, err
+	}
+	var gen genesis.CustomGenesis
+	if err := json.Unmarshal(data, &gen); err != nil {
+		return nil, err
+	}
+	gene, err := genesis.NewCustomNet(&gen)
+	if err != nil {
+		return nil, err
+	}
+	return newChain(gene, genesis.DevAccounts()[:authorities])
+}
+
+// GenesisJSON returns the custom genesis file, whose authority nodes are the first count dev accounts,
+// each endorsed by itself without endorsement required.
+func GenesisJSON(authorities int) ([]byte, error) {
+	accs := genesis.DevAccounts()
+	if authorities < 1 || authorities > len(accs) {
+		return nil, fmt.Errorf("count of authorities out of range [1, %v]", len(accs))
+	}
+	nodes := make([]string, 0, authorities)
+	for _, acc := range accs[:authorities] {
+		nodes = append(nodes, fmt.Sprintf(`{"masterAddress":"%v","endorsorAddress":"%v","identity":"%v"}`,
+			acc.Address, acc.Address, thor.BytesToBytes32(acc.Address.Bytes())))
+	}
+	return []byte(fmt.Sprintf(`{"launchTime":1526400000,"authority":[%v],"params":{"proposerEndorsement":0}}`,
+		strings.Join(nodes, ","))), nil
+}
+
+func newChain(gene *genesis.Genesis, authorities []genesis.DevAccount) (*Chain, error) {
+	db := muxdb.NewMem()
+	b0, _, _, err := gene.Build(state.NewStater(db))
+	if err != nil {
+		return nil, err
+	}
+	repo, err := chain.NewRepository(db, b0)
+	if err != nil {
+		return nil, err
+	}
+	return New(db, repo, authorities), nil
+}
+
+// DB returns the underlying database.
+func (c *Chain) DB() *muxdb.MuxDB {
+	return c.db
+}
+
+// Stater returns the stater of the chain.
+func (c *Chain) Stater() *state.Stater {
+	return c.stater
+}
+
+// Repo returns the chain repository.
+func (c *Chain) Repo() *chain.Repository {
+	return c.repo
+}
+
+// NewBlock produces an empty block upon the parent at the timestamp, by the active proposer whose turn it is,
+// and adds it into the repository. The best block is left unchanged.
+// As in real networks, proposers whose turns are skipped get deactivated by the block.
+func (c *Chain) NewBlock(parent *block.Header, timestamp uint64) (*block.Block, error) {
+	st := c.stater.NewState(parent.StateRoot())
+	list, err := builtin.Authority.Native(st).AllCandidates()
+	if err != nil {
+		return nil, err
+	}
+	proposers, err := poa.NewCandidates(list).Pick(st)
+	if err != nil {
+		return nil, err
+	}
+	if len(proposers) == 0 {
+		return nil, errors.New("no proposer")
+	}
+	addr := proposers[0].Address
+	for _, p := range proposers {
+		if p.Active {
+			addr = p.Address
+			break
+		}
+	}
+	sched, err := poa.NewScheduler(addr, proposers, parent.Number(), parent.Timestamp())
+	if err != nil {
+		return nil, err
+	}
+	addr = sched.WhoseTurn(timestamp).Address
+
+	var signer *genesis.DevAccount
+	for i := range c.authorities {
+		if c.authorities[i].Address == addr {
+			signer = &c.authorities[i]
+			break
+		}
+	}
+	if signer == nil {
+		return nil, fmt.Errorf("proposer %v not controlled", addr)
+	}
+
+	flow, err := packer.New(c.repo, c.stater, signer.Address, &signer.Address, thor.NoFork).Schedule(parent, timestamp)
+	if err != nil {
+		return nil, err
+	}
+	if flow.When() != timestamp {
+		return nil, fmt.Errorf("timestamp %v not aligned to slots", timestamp)
+	}
+	blk, stage, receipts, err := flow.Pack(signer.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := stage.Commit(); err != nil {
+		return nil, err
+	}
+	if err := c.repo.AddBlock(blk, receipts); err != nil {
+		return nil, err
+	}
+	return blk, nil
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package thor
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+const maxK2 = 32
+
+// Bloom2 a bloom filter whose length is fixed by the capacity, to meet the target false positive rate.
+// Bits are located by double hashing, with two uint32 taken from the blake2b hash of the item.
+type Bloom2 struct {
+	Bits []byte
+	K    int
+}
+
+// NewBloom2 creates a bloom holding up to capacity items, with the false positive rate fpRate in (0, 1).
+// The false positive rate rises if more items added.
+func NewBloom2(capacity int, fpRate float64) *Bloom2 {
+	if fpRate <= 0 || fpRate >= 1 {
+		panic("bloom2: invalid false positive rate")
+	}
+	bitsPerItem := -math.Log(fpRate) / (math.Ln2 * math.Ln2)
+
+	k := int(math.Round(bitsPerItem * math.Ln2))
+	if k > maxK2 {
+		k = maxK2
+	}
+	if k < 1 {
+		k = 1
+	}
+
+	n := int(math.Ceil(float64(capacity)*bitsPerItem+7)) / 8
+	if n < 1 {
+		n = 1
+	}
+	return &Bloom2{Bits: make([]byte, n), K: k}
+}
+
+// Add add item into bloom.
+func (b *Bloom2) Add(item []byte) {
+	b.distribute(item, func(index int, bit byte) bool {
+		b.Bits[index] |= bit
+		return true
+	})
+}
+
+// Test test if item contained. (false positive)
+func (b *Bloom2) Test(item []byte) bool {
+	return b.distribute(item, func(index int, bit byte) bool {
+		return b.Bits[index]&bit == bit
+	})
+}
+
+func (b *Bloom2) distribute(item []byte, cb func(index int, bit byte) bool) bool {
+	hash := Blake2b(item)
+	h1 := uint64(binary.BigEndian.Uint32(hash[:]))
+	h2 := uint64(binary.BigEndian.Uint32(hash[4:]))
+	length := uint64(len(b.Bits)) * 8
+	for i := uint64(0); i < uint64(b.K); i++ {
+		d := (h1 + i*h2) % length
+		bit := byte(1) << (d % 8)
+		if !cb(int(d/8), bit) {
+			return false
+		}
+	}
+	return true
+}
@@ -26,3 +26,30 @@ func TestBloom(t *testing.T) {
 		assert.Equal(t, true, bloom.Test([]byte(fmt.Sprintf("%v", i))))
 	}
 }
+
+func TestBloom2(t *testing.T) {
+	itemCount := 1000
+	bloom := thor.NewBloom2(itemCount, 0.001)
+	assert.Equal(t, 10, bloom.K)
+	assert.Equal(t, 1798, len(bloom.Bits))
+
+	for i := 0; i < itemCount; i++ {
+		bloom.Add([]byte(fmt.Sprintf("%v", i)))
+	}
+
+	for i := 0; i < itemCount; i++ {
+		assert.Equal(t, true, bloom.Test([]byte(fmt.Sprintf("%v", i))))
+	}
+
+	falsePositives := 0
+	for i := itemCount; i < itemCount*11; i++ {
+		if bloom.Test([]byte(fmt.Sprintf("%v", i))) {
+			falsePositives++
+		}
+	}
+	assert.True(t, falsePositives < 50, "false positive rate should be around 0.001")
+
+	empty := thor.NewBloom2(0, 0.001)
+	assert.Equal(t, 1, len(empty.Bits))
+	assert.False(t, empty.Test([]byte("foo")))
+}
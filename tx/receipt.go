@@ -6,6 +6,7 @@
 package tx
 
 import (
+	"io"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/rlp"
@@ -27,6 +28,48 @@ type Receipt struct {
 	Reverted bool
 	// outputs of clauses in tx
 	Outputs []*Output
+	// return data of the failed clause if the tx reverted.
+	// it's not part of consensus and excluded from the RLP encoding.
+	RevertData []byte
+}
+
+// the consensus part of receipt.
+type receiptBody struct {
+	GasUsed  uint64
+	GasPayer thor.Address
+	Paid     *big.Int
+	Reward   *big.Int
+	Reverted bool
+	Outputs  []*Output
+}
+
+// EncodeRLP implements rlp.Encoder.
+func (r *Receipt) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, &receiptBody{
+		r.GasUsed,
+		r.GasPayer,
+		r.Paid,
+		r.Reward,
+		r.Reverted,
+		r.Outputs,
+	})
+}
+
+// DecodeRLP implements rlp.Decoder.
+func (r *Receipt) DecodeRLP(s *rlp.Stream) error {
+	var body receiptBody
+	if err := s.Decode(&body); err != nil {
+		return err
+	}
+	*r = Receipt{
+		GasUsed:  body.GasUsed,
+		GasPayer: body.GasPayer,
+		Paid:     body.Paid,
+		Reward:   body.Reward,
+		Reverted: body.Reverted,
+		Outputs:  body.Outputs,
+	}
+	return nil
 }
 
 // Output output of clause execution.
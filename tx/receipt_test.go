@@ -7,8 +7,11 @@ package tx_test
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
 	. "github.com/vechain/thor/tx"
 )
 
@@ -19,3 +22,23 @@ func TestReceipt(t *testing.T) {
 	var txs Transactions
 	fmt.Println(txs.RootHash())
 }
+
+func TestReceiptRLP(t *testing.T) {
+	r := &Receipt{
+		GasUsed:    21000,
+		Paid:       big.NewInt(1),
+		Reward:     big.NewInt(2),
+		Reverted:   true,
+		Outputs:    []*Output{},
+		RevertData: []byte{1, 2, 3},
+	}
+	data, err := rlp.EncodeToBytes(r)
+	assert.Nil(t, err)
+
+	var decoded Receipt
+	assert.Nil(t, rlp.DecodeBytes(data, &decoded))
+	assert.Nil(t, decoded.RevertData, "revert data should not be encoded")
+
+	r.RevertData = nil
+	assert.Equal(t, r, &decoded)
+}
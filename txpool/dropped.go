@@ -0,0 +1,47 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/vechain/thor/thor"
+)
+
+// max count of dropped txs to be remembered.
+const maxDroppedTxs = 10000
+
+// DropInfo records why and when a tx was dropped, i.e. evicted or rejected by the pool.
+type DropInfo struct {
+	Reason string
+	Time   time.Time
+}
+
+// droppedTxs bounded store of recently dropped txs, the least recently dropped are forgotten first.
+type droppedTxs struct {
+	cache *lru.Cache
+}
+
+func newDroppedTxs(size int) *droppedTxs {
+	cache, _ := lru.New(size)
+	return &droppedTxs{cache}
+}
+
+func (d *droppedTxs) add(txID thor.Bytes32, reason string) {
+	d.cache.Add(txID, &DropInfo{reason, time.Now()})
+}
+
+func (d *droppedTxs) get(txID thor.Bytes32) *DropInfo {
+	if info, ok := d.cache.Get(txID); ok {
+		return info.(*DropInfo)
+	}
+	return nil
+}
+
+func (d *droppedTxs) remove(txID thor.Bytes32) {
+	d.cache.Remove(txID)
+}
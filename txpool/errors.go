@@ -7,7 +7,10 @@ package txpool
 
 type (
 	badTxError      struct{ msg string }
-	txRejectedError struct{ msg string }
+	txRejectedError struct {
+		msg  string
+		code string // reason code of policy rejection
+	}
 )
 
 func (e badTxError) Error() string {
@@ -18,6 +21,14 @@ func (e txRejectedError) Error() string {
 	return "tx rejected: " + e.msg
 }
 
+// policyRejected converts the error returned by policy into rejection error.
+func policyRejected(err error) error {
+	if pe, ok := err.(*PolicyError); ok {
+		return txRejectedError{msg: pe.Message, code: pe.Code}
+	}
+	return txRejectedError{msg: err.Error()}
+}
+
 // IsBadTx returns whether the given error indicates that tx is bad.
 func IsBadTx(err error) bool {
 	_, ok := err.(badTxError)
@@ -29,3 +40,11 @@ func IsTxRejected(err error) bool {
 	_, ok := err.(txRejectedError)
 	return ok
 }
+
+// RejectCode returns the reason code if the tx is rejected by policy, otherwise empty string.
+func RejectCode(err error) string {
+	if e, ok := err.(txRejectedError); ok {
+		return e.code
+	}
+	return ""
+}
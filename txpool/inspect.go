@@ -0,0 +1,164 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/runtime"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+// reasons why txs in the pool are not executable.
+const (
+	reasonDependsOnNotMet       = "dependsOn not met"
+	reasonFutureBlockRef        = "future blockRef"
+	reasonInsufficientEnergy    = "insufficient energy"
+	reasonGasTooLarge           = "gas too large"
+	reasonExpired               = "expired"
+	reasonBlockRefOutOfSchedule = "blockRef out of schedule"
+	reasonDependsOnReverted     = "dependsOn reverted"
+	reasonPacked                = "packed"
+)
+
+// Status summary of txs in the pool.
+type Status struct {
+	Total           int
+	Executable      int // executables are evaluated in pool's housekeeping, so may be out-of-date
+	NonExecutable   int
+	Local           int
+	Remote          int
+	LimitPerAccount int
+	Accounts        map[thor.Address]int // count of txs per origin
+}
+
+// TxInfo describes a tx in the pool.
+type TxInfo struct {
+	Tx              *tx.Transaction
+	Origin          thor.Address
+	Local           bool
+	TimeAdded       time.Time
+	OverallGasPrice *big.Int // nil if proved work can't be evaluated
+	Executable      bool
+	Reason          string // why tx is not executable, empty if executable
+}
+
+// Status returns the summary of txs in the pool.
+func (p *TxPool) Status() *Status {
+	executables := make(map[thor.Bytes32]bool)
+	for _, tx := range p.Executables() {
+		executables[tx.Hash()] = true
+	}
+
+	status := &Status{
+		LimitPerAccount: p.options.LimitPerAccount,
+		Accounts:        make(map[thor.Address]int),
+	}
+	for _, txObj := range p.all.ToTxObjects() {
+		status.Total++
+		if executables[txObj.Hash()] {
+			status.Executable++
+		} else {
+			status.NonExecutable++
+		}
+		if txObj.localSubmitted {
+			status.Local++
+		} else {
+			status.Remote++
+		}
+		status.Accounts[txObj.Origin()]++
+	}
+	return status
+}
+
+// Inspect evaluates txs in the pool against the best block, and returns them ordered by time added.
+// Only txs sent by origin are returned if origin is not nil.
+func (p *TxPool) Inspect(origin *thor.Address) ([]*TxInfo, error) {
+	headBlock := p.repo.BestBlock().Header()
+	state := p.stater.NewState(headBlock.StateRoot())
+	baseGasPrice, err := builtin.Params.Native(state).Get(thor.KeyBaseGasPrice)
+	if err != nil {
+		return nil, err
+	}
+	chain := p.repo.NewChain(headBlock.ID())
+
+	var infos []*TxInfo
+	for _, txObj := range p.all.ToTxObjects() {
+		if origin != nil && *origin != txObj.Origin() {
+			continue
+		}
+		info := &TxInfo{
+			Tx:        txObj.Transaction,
+			Origin:    txObj.Origin(),
+			Local:     txObj.localSubmitted,
+			TimeAdded: time.Unix(0, txObj.timeAdded),
+		}
+		if provedWork, err := txObj.ProvedWork(headBlock.Number(), chain.GetBlockID); err == nil {
+			info.OverallGasPrice = txObj.OverallGasPrice(baseGasPrice, provedWork)
+		}
+
+		executable, err := txObj.Executable(chain, state, headBlock)
+		switch {
+		case err != nil:
+			// will be washed out
+			info.Reason = notExecutableReason(err)
+		case !executable:
+			info.Reason = txObj.pendingReason(chain)
+		default:
+			info.Executable = true
+		}
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].TimeAdded.Before(infos[j].TimeAdded)
+	})
+	return infos, nil
+}
+
+// RemoveByID removes tx from pool by its ID, on behalf of admin.
+func (p *TxPool) RemoveByID(id thor.Bytes32) bool {
+	if txObj := p.all.GetByID(id); txObj != nil {
+		return p.remove(txObj.Hash(), id, "evicted by admin")
+	}
+	return false
+}
+
+// pendingReason tells why tx is not yet executable, when it's not executable without error.
+// According to txObject.Executable, it's either the dependency not packed, or the block ref in future.
+func (o *txObject) pendingReason(chain *chain.Chain) string {
+	if dep := o.DependsOn(); dep != nil {
+		if _, err := chain.GetTransactionMeta(*dep); err != nil {
+			return reasonDependsOnNotMet
+		}
+	}
+	return reasonFutureBlockRef
+}
+
+// notExecutableReason maps the error returned by txObject.Executable to the reason.
+// Unexpected errors, e.g. db errors, are reported as is.
+func notExecutableReason(err error) string {
+	switch err {
+	case errKnownTx:
+		return reasonPacked
+	case errGasTooLarge:
+		return reasonGasTooLarge
+	case errExpired:
+		return reasonExpired
+	case errBlockRefOutOfSchedule:
+		return reasonBlockRefOutOfSchedule
+	case errDepReverted:
+		return reasonDependsOnReverted
+	case runtime.ErrInsufficientEnergy:
+		return reasonInsufficientEnergy
+	default:
+		return err.Error()
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/runtime"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestInspect(t *testing.T) {
+	pool := newPool(LIMIT, LIMIT_PER_ACCOUNT)
+	defer pool.Close()
+	b1 := new(block.Builder).
+		ParentID(pool.repo.GenesisBlock().Header().ID()).
+		Timestamp(uint64(time.Now().Unix())).
+		TotalScore(100).
+		GasLimit(10000000).
+		StateRoot(pool.repo.GenesisBlock().Header().StateRoot()).
+		Build()
+	pool.repo.AddBlock(b1, nil)
+	pool.repo.SetBestBlockID(b1.Header().ID())
+	acc0 := genesis.DevAccounts()[0]
+	acc1 := genesis.DevAccounts()[1]
+
+	tx1 := newTx(pool.repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), acc0)
+	tx2 := newTx(pool.repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, &thor.Bytes32{1}, tx.Features(0), acc0)
+	tx3 := newTx(pool.repo.ChainTag(), nil, 21000, tx.NewBlockRef(10), 100, nil, tx.Features(0), acc1)
+	assert.Nil(t, pool.AddLocal(tx1))
+	assert.Nil(t, pool.Add(tx2))
+	assert.Nil(t, pool.Add(tx3))
+
+	executables, _, err := pool.wash(pool.repo.BestBlock().Header())
+	assert.Nil(t, err)
+	pool.executables.Store(executables)
+
+	assert.Equal(t, &Status{
+		Total:           3,
+		Executable:      1,
+		NonExecutable:   2,
+		Local:           1,
+		Remote:          2,
+		LimitPerAccount: LIMIT_PER_ACCOUNT,
+		Accounts:        map[thor.Address]int{acc0.Address: 2, acc1.Address: 1},
+	}, pool.Status())
+
+	infos, err := pool.Inspect(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(infos))
+	reasons := make(map[thor.Bytes32]string)
+	for _, info := range infos {
+		reasons[info.Tx.ID()] = info.Reason
+		assert.Equal(t, info.Reason == "", info.Executable)
+		assert.NotNil(t, info.OverallGasPrice)
+	}
+	assert.Equal(t, map[thor.Bytes32]string{
+		tx1.ID(): "",
+		tx2.ID(): "dependsOn not met",
+		tx3.ID(): "future blockRef",
+	}, reasons)
+
+	infos, err = pool.Inspect(&acc1.Address)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(infos))
+	assert.Equal(t, tx3.ID(), infos[0].Tx.ID())
+	assert.False(t, infos[0].Local)
+
+	// reasons of txs to be washed out
+	assert.Equal(t, "insufficient energy", notExecutableReason(runtime.ErrInsufficientEnergy))
+	assert.Equal(t, "packed", notExecutableReason(errKnownTx))
+	assert.Equal(t, "dependsOn reverted", notExecutableReason(errDepReverted))
+
+	assert.True(t, pool.RemoveByID(tx3.ID()))
+	assert.False(t, pool.RemoveByID(tx3.ID()))
+	assert.Equal(t, 2, pool.Len())
+}
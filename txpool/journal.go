@@ -0,0 +1,127 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/tx"
+)
+
+// interval to write the journal
+const journalInterval = time.Minute
+
+// journalEntry the persisted form of tx object.
+type journalEntry struct {
+	Tx             *tx.Transaction
+	LocalSubmitted bool
+	TimeAdded      uint64 // in nano seconds
+}
+
+// writeJournal writes all txs in the pool into the journal file.
+// The file is replaced atomically, so an interrupted write won't corrupt the previous journal.
+func (p *TxPool) writeJournal() (int, error) {
+	path := p.options.JournalPath
+	tmpPath := path + ".tmp"
+
+	file, err := os.Create(tmpPath)
+	if err != nil {
+		return 0, err
+	}
+	w := bufio.NewWriter(file)
+
+	txObjs := p.all.ToTxObjects()
+	for _, txObj := range txObjs {
+		if err := rlp.Encode(w, &journalEntry{
+			txObj.Transaction,
+			txObj.localSubmitted,
+			uint64(txObj.timeAdded),
+		}); err != nil {
+			file.Close()
+			return 0, err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		file.Close()
+		return 0, err
+	}
+	if err := file.Close(); err != nil {
+		return 0, err
+	}
+	return len(txObjs), os.Rename(tmpPath, path)
+}
+
+// loadJournal loads txs from the journal file, and adds them into the pool.
+// Txs are re-validated as newly received ones, while their local flags and time added are restored.
+func (p *TxPool) loadJournal() (loaded int, err error) {
+	file, err := os.Open(p.options.JournalPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	defer file.Close()
+
+	var (
+		stream = rlp.NewStream(bufio.NewReader(file), 0)
+		now    = time.Now().UnixNano()
+	)
+	for {
+		var entry journalEntry
+		if err := stream.Decode(&entry); err != nil {
+			if err == io.EOF {
+				return loaded, nil
+			}
+			// the rest is broken
+			return loaded, err
+		}
+
+		timeAdded := int64(entry.TimeAdded)
+		if p.isOutOfLifetime(entry.LocalSubmitted, timeAdded, now) {
+			continue
+		}
+		if err := p.add(entry.Tx, false, entry.LocalSubmitted, true); err != nil {
+			log.Debug("journaled tx rejected", "id", entry.Tx.ID(), "err", err)
+			continue
+		}
+		if txObj := p.all.GetByID(entry.Tx.ID()); txObj != nil {
+			txObj.timeAdded = timeAdded
+			loaded++
+		}
+	}
+}
+
+func (p *TxPool) journalLoop() {
+	log.Debug("enter journal loop")
+	defer log.Debug("leave journal loop")
+
+	ticker := time.NewTicker(journalInterval)
+	defer ticker.Stop()
+
+	write := func() {
+		if n, err := p.writeJournal(); err != nil {
+			log.Warn("failed to write journal", "err", err)
+		} else {
+			log.Debug("journal written", "count", n)
+		}
+	}
+
+	for {
+		select {
+		case <-p.ctx.Done():
+			// write on close
+			write()
+			return
+		case <-ticker.C:
+			write()
+		}
+	}
+}
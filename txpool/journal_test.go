@@ -0,0 +1,60 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/tx"
+)
+
+func TestJournal(t *testing.T) {
+	dir, err := ioutil.TempDir("", "txpool-journal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	db := muxdb.NewMem()
+	repo := newChainRepo(db)
+	options := Options{
+		Limit:           LIMIT,
+		LimitPerAccount: LIMIT_PER_ACCOUNT,
+		MaxLifetime:     time.Hour,
+		JournalPath:     filepath.Join(dir, "txpool.journal"),
+	}
+
+	pool := New(repo, state.NewStater(db), options)
+	tx1 := newTx(repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), genesis.DevAccounts()[0])
+	tx2 := newTx(repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), genesis.DevAccounts()[1])
+	tx3 := newTx(repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), genesis.DevAccounts()[2])
+	assert.Nil(t, pool.AddLocal(tx1))
+	assert.Nil(t, pool.Add(tx2))
+	assert.Nil(t, pool.Add(tx3))
+
+	timeAdded1 := pool.all.GetByID(tx1.ID()).timeAdded
+	// remote tx out of lifetime is not reloaded
+	pool.all.GetByID(tx3.ID()).timeAdded = time.Now().Add(-2 * time.Hour).UnixNano()
+	pool.Close()
+
+	pool = New(repo, state.NewStater(db), options)
+	defer pool.Close()
+
+	assert.Equal(t, 2, pool.all.Len())
+	txObj1 := pool.all.GetByID(tx1.ID())
+	assert.True(t, txObj1.localSubmitted)
+	assert.Equal(t, timeAdded1, txObj1.timeAdded)
+	assert.False(t, pool.all.GetByID(tx2.ID()).localSubmitted)
+	assert.Nil(t, pool.all.GetByID(tx3.ID()))
+}
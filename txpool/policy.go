@@ -0,0 +1,362 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Reason codes of policy rejections.
+const (
+	ReasonGasPriceCoefTooLow  = "gas_price_coef_too_low"
+	ReasonOriginNotAllowed    = "origin_not_allowed"
+	ReasonDelegatorNotAllowed = "delegator_not_allowed"
+	ReasonTooManyClauses      = "too_many_clauses"
+	ReasonForbiddenRecipient  = "forbidden_recipient"
+	ReasonRateLimited         = "rate_limited"
+)
+
+// PolicyError is returned by policy to reject a tx, with a machine-readable reason code.
+type PolicyError struct {
+	Code    string
+	Message string
+}
+
+func (e *PolicyError) Error() string {
+	return e.Message
+}
+
+// Policy decides which txs are allowed in the pool.
+type Policy interface {
+	// Admit is consulted when a tx is being added into the pool.
+	// The tx is rejected if an error returned, which is expected to be *PolicyError.
+	Admit(tx *tx.Transaction, origin thor.Address, delegator *thor.Address) error
+	// Retain is consulted when txs in the pool are washed.
+	// The tx is removed if an error returned.
+	Retain(tx *tx.Transaction, origin thor.Address, delegator *thor.Address) error
+}
+
+// Releaser is optionally implemented by policies which count admitted txs, e.g. rate limits.
+// Such a policy counts the tx in Admit, atomically with the check, so that concurrent submissions can't
+// all pass the check before any of them counted.
+type Releaser interface {
+	// Release undoes the count of a tx passing Admit, if the tx is not added into the pool finally,
+	// or it's added back into the pool, from orphaned blocks or the journal.
+	Release(tx *tx.Transaction, origin thor.Address, delegator *thor.Address)
+}
+
+// Policies combines policies. A tx should pass all of them.
+type Policies []Policy
+
+// Admit implements Policy.
+// If rejected, txs counted by the policies admitting it are released.
+func (ps Policies) Admit(tx *tx.Transaction, origin thor.Address, delegator *thor.Address) error {
+	for i, p := range ps {
+		if err := p.Admit(tx, origin, delegator); err != nil {
+			ps[:i].Release(tx, origin, delegator)
+			return err
+		}
+	}
+	return nil
+}
+
+// Retain implements Policy.
+func (ps Policies) Retain(tx *tx.Transaction, origin thor.Address, delegator *thor.Address) error {
+	for _, p := range ps {
+		if err := p.Retain(tx, origin, delegator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Release implements Releaser.
+func (ps Policies) Release(tx *tx.Transaction, origin thor.Address, delegator *thor.Address) {
+	for _, p := range ps {
+		if r, ok := p.(Releaser); ok {
+			r.Release(tx, origin, delegator)
+		}
+	}
+}
+
+// staticPolicy is the policy which only depends on the tx itself, so admission and retention are the same.
+type staticPolicy func(tx *tx.Transaction, origin thor.Address, delegator *thor.Address) error
+
+func (p staticPolicy) Admit(tx *tx.Transaction, origin thor.Address, delegator *thor.Address) error {
+	return p(tx, origin, delegator)
+}
+
+func (p staticPolicy) Retain(tx *tx.Transaction, origin thor.Address, delegator *thor.Address) error {
+	return p(tx, origin, delegator)
+}
+
+// MinGasPriceCoef rejects txs with gas price coef lower than min.
+func MinGasPriceCoef(min uint8) Policy {
+	return staticPolicy(func(tx *tx.Transaction, _ thor.Address, _ *thor.Address) error {
+		if tx.GasPriceCoef() < min {
+			return &PolicyError{ReasonGasPriceCoefTooLow, fmt.Sprintf("gas price coef lower than %d", min)}
+		}
+		return nil
+	})
+}
+
+// AllowedOrigins rejects txs not sent by the given origins.
+func AllowedOrigins(origins []thor.Address) Policy {
+	set := toAddressSet(origins)
+	return staticPolicy(func(_ *tx.Transaction, origin thor.Address, _ *thor.Address) error {
+		if !set[origin] {
+			return &PolicyError{ReasonOriginNotAllowed, "origin not allowed"}
+		}
+		return nil
+	})
+}
+
+// AllowedDelegators rejects delegated txs not paid by the given delegators.
+// Txs without delegator are not affected.
+func AllowedDelegators(delegators []thor.Address) Policy {
+	set := toAddressSet(delegators)
+	return staticPolicy(func(_ *tx.Transaction, _ thor.Address, delegator *thor.Address) error {
+		if delegator != nil && !set[*delegator] {
+			return &PolicyError{ReasonDelegatorNotAllowed, "delegator not allowed"}
+		}
+		return nil
+	})
+}
+
+// MaxClauses rejects txs with more than max clauses.
+func MaxClauses(max int) Policy {
+	return staticPolicy(func(tx *tx.Transaction, _ thor.Address, _ *thor.Address) error {
+		if len(tx.Clauses()) > max {
+			return &PolicyError{ReasonTooManyClauses, fmt.Sprintf("more than %d clauses", max)}
+		}
+		return nil
+	})
+}
+
+// ForbiddenRecipients rejects txs with any clause sent to the given addresses.
+func ForbiddenRecipients(recipients []thor.Address) Policy {
+	set := toAddressSet(recipients)
+	return staticPolicy(func(tx *tx.Transaction, _ thor.Address, _ *thor.Address) error {
+		for _, c := range tx.Clauses() {
+			if to := c.To(); to != nil && set[*to] {
+				return &PolicyError{ReasonForbiddenRecipient, "recipient forbidden: " + to.String()}
+			}
+		}
+		return nil
+	})
+}
+
+// rateLimit limits the count of txs admitted per origin, and optionally per delegator, in a time window.
+// Admit checks and increases the count under the lock, and Release decreases it.
+type rateLimit struct {
+	limit         int
+	interval      time.Duration
+	withDelegator bool
+	lock          sync.Mutex
+	windows       map[thor.Address]*rateWindow
+}
+
+type rateWindow struct {
+	start int64
+	count int
+}
+
+// max count of tracked senders before stale windows are purged
+const maxRateWindows = 10000
+
+// RateLimit limits each origin to have at most limit txs admitted in the interval.
+func RateLimit(limit int, interval time.Duration) Policy {
+	return newRateLimit(limit, interval, false)
+}
+
+// SenderRateLimit limits each origin and delegator to have at most limit txs admitted in the interval.
+func SenderRateLimit(limit int, interval time.Duration) Policy {
+	return newRateLimit(limit, interval, true)
+}
+
+func newRateLimit(limit int, interval time.Duration, withDelegator bool) *rateLimit {
+	return &rateLimit{
+		limit:         limit,
+		interval:      interval,
+		withDelegator: withDelegator,
+		windows:       make(map[thor.Address]*rateWindow),
+	}
+}
+
+func (r *rateLimit) senders(origin thor.Address, delegator *thor.Address) []thor.Address {
+	if r.withDelegator && delegator != nil {
+		return []thor.Address{origin, *delegator}
+	}
+	return []thor.Address{origin}
+}
+
+func (r *rateLimit) Admit(_ *tx.Transaction, origin thor.Address, delegator *thor.Address) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := time.Now().UnixNano()
+	senders := r.senders(origin, delegator)
+	for _, sender := range senders {
+		if w := r.windows[sender]; w != nil && now-w.start < int64(r.interval) && w.count >= r.limit {
+			return &PolicyError{ReasonRateLimited, fmt.Sprintf("more than %d txs in %v", r.limit, r.interval)}
+		}
+	}
+
+	if len(r.windows) >= maxRateWindows {
+		for addr, w := range r.windows {
+			if now-w.start >= int64(r.interval) {
+				delete(r.windows, addr)
+			}
+		}
+	}
+
+	for _, sender := range senders {
+		w := r.windows[sender]
+		if w == nil || now-w.start >= int64(r.interval) {
+			w = &rateWindow{start: now}
+			r.windows[sender] = w
+		}
+		w.count++
+	}
+	return nil
+}
+
+func (r *rateLimit) Retain(*tx.Transaction, thor.Address, *thor.Address) error {
+	return nil
+}
+
+func (r *rateLimit) Release(_ *tx.Transaction, origin thor.Address, delegator *thor.Address) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, sender := range r.senders(origin, delegator) {
+		// the window might have been renewed since admitted, which is fine to be released as well
+		if w := r.windows[sender]; w != nil {
+			if w.count--; w.count <= 0 {
+				delete(r.windows, sender)
+			}
+		}
+	}
+}
+
+func toAddressSet(addrs []thor.Address) map[thor.Address]bool {
+	set := make(map[thor.Address]bool, len(addrs))
+	for _, addr := range addrs {
+		set[addr] = true
+	}
+	return set
+}
+
+// PolicyConfig the config of built-in policies, which can be in either JSON or YAML format.
+// Zero values mean not limited.
+type PolicyConfig struct {
+	MinGasPriceCoef     uint8            `json:"minGasPriceCoef" yaml:"minGasPriceCoef"`
+	AllowedOrigins      []string         `json:"allowedOrigins" yaml:"allowedOrigins"`
+	AllowedDelegators   []string         `json:"allowedDelegators" yaml:"allowedDelegators"`
+	MaxClauses          int              `json:"maxClauses" yaml:"maxClauses"`
+	ForbiddenRecipients []string         `json:"forbiddenRecipients" yaml:"forbiddenRecipients"`
+	RateLimit           *RateLimitConfig `json:"rateLimit" yaml:"rateLimit"`
+}
+
+// RateLimitConfig the config of per origin rate limit.
+type RateLimitConfig struct {
+	Limit    int    `json:"limit" yaml:"limit"`
+	Interval string `json:"interval" yaml:"interval"` // e.g. '1m'
+}
+
+// Build builds policies from the config.
+func (c *PolicyConfig) Build() (Policy, error) {
+	var policies Policies
+
+	if c.MinGasPriceCoef > 0 {
+		policies = append(policies, MinGasPriceCoef(c.MinGasPriceCoef))
+	}
+	if len(c.AllowedOrigins) > 0 {
+		origins, err := parseAddresses(c.AllowedOrigins)
+		if err != nil {
+			return nil, errors.WithMessage(err, "allowedOrigins")
+		}
+		policies = append(policies, AllowedOrigins(origins))
+	}
+	if len(c.AllowedDelegators) > 0 {
+		delegators, err := parseAddresses(c.AllowedDelegators)
+		if err != nil {
+			return nil, errors.WithMessage(err, "allowedDelegators")
+		}
+		policies = append(policies, AllowedDelegators(delegators))
+	}
+	if c.MaxClauses > 0 {
+		policies = append(policies, MaxClauses(c.MaxClauses))
+	}
+	if len(c.ForbiddenRecipients) > 0 {
+		recipients, err := parseAddresses(c.ForbiddenRecipients)
+		if err != nil {
+			return nil, errors.WithMessage(err, "forbiddenRecipients")
+		}
+		policies = append(policies, ForbiddenRecipients(recipients))
+	}
+	if c.RateLimit != nil {
+		if c.RateLimit.Limit <= 0 {
+			return nil, errors.New("rateLimit.limit: should be greater than 0")
+		}
+		interval, err := time.ParseDuration(c.RateLimit.Interval)
+		if err != nil {
+			return nil, errors.WithMessage(err, "rateLimit.interval")
+		}
+		if interval <= 0 {
+			return nil, errors.New("rateLimit.interval: should be greater than 0")
+		}
+		policies = append(policies, RateLimit(c.RateLimit.Limit, interval))
+	}
+	return policies, nil
+}
+
+func parseAddresses(strs []string) ([]thor.Address, error) {
+	addrs := make([]thor.Address, 0, len(strs))
+	for _, str := range strs {
+		addr, err := thor.ParseAddress(str)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+// LoadPolicy loads built-in policies from the config file.
+// The file is parsed as YAML if it has '.yaml' or '.yml' extension, otherwise JSON.
+func LoadPolicy(path string) (Policy, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var config PolicyConfig
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.UnmarshalStrict(data, &config)
+	default:
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&config)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return config.Build()
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func reasonCode(err error) string {
+	if pe, ok := err.(*PolicyError); ok {
+		return pe.Code
+	}
+	return ""
+}
+
+func TestPolicies(t *testing.T) {
+	acc0 := genesis.DevAccounts()[0].Address
+	acc1 := genesis.DevAccounts()[1].Address
+	to := thor.BytesToAddress([]byte("to"))
+
+	trx := new(tx.Builder).
+		GasPriceCoef(10).
+		Clause(tx.NewClause(&to)).
+		Clause(tx.NewClause(nil)).
+		Build()
+
+	tests := []struct {
+		policy Policy
+		code   string
+	}{
+		{MinGasPriceCoef(10), ""},
+		{MinGasPriceCoef(11), ReasonGasPriceCoefTooLow},
+		{AllowedOrigins([]thor.Address{acc0}), ""},
+		{AllowedOrigins([]thor.Address{acc1}), ReasonOriginNotAllowed},
+		{MaxClauses(2), ""},
+		{MaxClauses(1), ReasonTooManyClauses},
+		{ForbiddenRecipients([]thor.Address{acc1}), ""},
+		{ForbiddenRecipients([]thor.Address{to}), ReasonForbiddenRecipient},
+		{Policies{MinGasPriceCoef(10), MaxClauses(1)}, ReasonTooManyClauses},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.code, reasonCode(tt.policy.Admit(trx, acc0, nil)))
+		assert.Equal(t, tt.code, reasonCode(tt.policy.Retain(trx, acc0, nil)))
+	}
+
+	delegators := AllowedDelegators([]thor.Address{acc1})
+	assert.Nil(t, delegators.Admit(trx, acc0, nil), "not delegated")
+	assert.Nil(t, delegators.Admit(trx, acc0, &acc1))
+	assert.Equal(t, ReasonDelegatorNotAllowed, reasonCode(delegators.Admit(trx, acc0, &acc0)))
+}
+
+func TestRateLimit(t *testing.T) {
+	acc0 := genesis.DevAccounts()[0].Address
+	acc1 := genesis.DevAccounts()[1].Address
+	trx := new(tx.Builder).Build()
+
+	limit := RateLimit(2, 100*time.Millisecond).(*rateLimit)
+	admit := func(origin thor.Address, delegator *thor.Address) error {
+		return limit.Admit(trx, origin, delegator)
+	}
+	assert.Nil(t, admit(acc0, nil))
+	limit.Release(trx, acc0, nil)
+	assert.Nil(t, limit.windows[acc0], "released")
+	assert.Nil(t, admit(acc0, nil))
+	assert.Nil(t, admit(acc0, nil))
+	assert.Equal(t, ReasonRateLimited, reasonCode(admit(acc0, nil)))
+	assert.Nil(t, admit(acc1, nil), "limited per origin")
+	assert.Nil(t, admit(acc1, &acc0), "delegator not limited")
+	assert.Nil(t, limit.Retain(trx, acc0, nil))
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Nil(t, admit(acc0, nil))
+
+	senderLimit := SenderRateLimit(1, time.Minute)
+	assert.Nil(t, senderLimit.Admit(trx, acc0, &acc1))
+	assert.Equal(t, ReasonRateLimited, reasonCode(senderLimit.Admit(trx, acc1, nil)), "limited per delegator")
+
+	// counted ones released if rejected by later policies
+	limit = RateLimit(1, time.Minute).(*rateLimit)
+	policies := Policies{limit, MinGasPriceCoef(1)}
+	assert.Equal(t, ReasonGasPriceCoefTooLow, reasonCode(policies.Admit(trx, acc0, nil)))
+	assert.Nil(t, limit.windows[acc0])
+
+	// concurrent admissions never exceed the limit
+	limit = RateLimit(10, time.Minute).(*rateLimit)
+	var (
+		wg       sync.WaitGroup
+		admitted int32
+	)
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if limit.Admit(trx, acc0, nil) == nil {
+				atomic.AddInt32(&admitted, 1)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(10), admitted)
+}
+
+func TestLoadPolicy(t *testing.T) {
+	dir, err := ioutil.TempDir("", "txpool-policy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	acc0 := genesis.DevAccounts()[0].Address
+	trx := new(tx.Builder).GasPriceCoef(1).Build()
+
+	yamlPath := filepath.Join(dir, "policy.yaml")
+	ioutil.WriteFile(yamlPath, []byte("minGasPriceCoef: 2\nrateLimit:\n  limit: 10\n  interval: 1m\n"), 0644)
+	policy, err := LoadPolicy(yamlPath)
+	assert.Nil(t, err)
+	assert.Equal(t, ReasonGasPriceCoefTooLow, reasonCode(policy.Admit(trx, acc0, nil)))
+
+	jsonPath := filepath.Join(dir, "policy.json")
+	ioutil.WriteFile(jsonPath, []byte(`{"allowedOrigins":["`+acc0.String()+`"],"maxClauses":1}`), 0644)
+	policy, err = LoadPolicy(jsonPath)
+	assert.Nil(t, err)
+	assert.Nil(t, policy.Admit(trx, acc0, nil))
+
+	ioutil.WriteFile(jsonPath, []byte(`{"allowedOrigins":["0x1"]}`), 0644)
+	_, err = LoadPolicy(jsonPath)
+	assert.NotNil(t, err)
+
+	ioutil.WriteFile(jsonPath, []byte(`{"unknown":1}`), 0644)
+	_, err = LoadPolicy(jsonPath)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/vechain/thor/thor"
+)
+
+const (
+	// max count of senders to be tracked.
+	maxTrackedSenders = 100000
+	// half-life of counts of submitted and washed out txs, which the score is based on.
+	scoreHalfLife = 30 * time.Minute
+)
+
+// senderStats behaviour statistics of a tx sender, i.e. an origin or a delegator.
+// All counts are exponentially decayed over time.
+type senderStats struct {
+	submitted float64 // submitted txs
+	washedOut float64 // txs washed out as non-executable
+	updated   int64   // last update time in unix nano
+}
+
+func (s *senderStats) decay(now int64) {
+	if elapsed := float64(now - s.updated); elapsed > 0 {
+		f := math.Pow(0.5, elapsed/float64(scoreHalfLife))
+		s.submitted *= f
+		s.washedOut *= f
+	}
+	s.updated = now
+}
+
+// score ranges in (0, 1]. The more submitted txs washed out, the lower the score.
+func (s *senderStats) score() float64 {
+	return (1 + s.submitted - s.washedOut) / (1 + s.submitted)
+}
+
+// scoreboard tracks stats of tx senders. Txs submitted locally and relayed by peers are
+// tracked by separate scoreboards, so that scores apply separately.
+type scoreboard struct {
+	lock  sync.Mutex
+	stats *lru.Cache
+}
+
+func newScoreboard(size int) *scoreboard {
+	cache, _ := lru.New(size)
+	return &scoreboard{stats: cache}
+}
+
+func (b *scoreboard) get(sender thor.Address, now int64) *senderStats {
+	if s, ok := b.stats.Get(sender); ok {
+		stats := s.(*senderStats)
+		stats.decay(now)
+		return stats
+	}
+	stats := &senderStats{updated: now}
+	b.stats.Add(sender, stats)
+	return stats
+}
+
+// submit records a tx of senders is admitted into the pool.
+func (b *scoreboard) submit(senders []thor.Address) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	now := time.Now().UnixNano()
+	for _, sender := range senders {
+		b.get(sender, now).submitted++
+	}
+}
+
+// washOut records a tx of senders is washed out as non-executable.
+func (b *scoreboard) washOut(senders []thor.Address) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	now := time.Now().UnixNano()
+	for _, sender := range senders {
+		stats := b.get(sender, now)
+		stats.washedOut++
+		if stats.washedOut > stats.submitted {
+			// submission might be recorded by the other scoreboard, or forgotten
+			stats.submitted = stats.washedOut
+		}
+	}
+}
+
+// score returns the lowest score among senders.
+func (b *scoreboard) score(senders []thor.Address) float64 {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	now := time.Now().UnixNano()
+	score := 1.0
+	for _, sender := range senders {
+		if s, ok := b.stats.Peek(sender); ok {
+			stats := s.(*senderStats)
+			stats.decay(now)
+			score = math.Min(score, stats.score())
+		}
+	}
+	return score
+}
+
+// senders returns origin and delegator (if any) of the tx.
+func (o *txObject) senders() []thor.Address {
+	if delegator := o.resolved.Delegator; delegator != nil {
+		return []thor.Address{o.Origin(), *delegator}
+	}
+	return []thor.Address{o.Origin()}
+}
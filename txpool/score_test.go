@@ -0,0 +1,81 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestScoreboard(t *testing.T) {
+	acc0 := genesis.DevAccounts()[0].Address
+	acc1 := genesis.DevAccounts()[1].Address
+
+	b := newScoreboard(10)
+	assert.Equal(t, 1.0, b.score([]thor.Address{acc0}), "unknown sender")
+
+	b.submit([]thor.Address{acc0, acc1})
+	b.submit([]thor.Address{acc0})
+	b.submit([]thor.Address{acc1})
+
+	assert.Equal(t, 1.0, b.score([]thor.Address{acc0, acc1}))
+	b.washOut([]thor.Address{acc1})
+	assert.True(t, b.score([]thor.Address{acc1}) < 1)
+	assert.Equal(t, b.score([]thor.Address{acc1}), b.score([]thor.Address{acc0, acc1}), "lowest score")
+	b.washOut([]thor.Address{acc1})
+	b.washOut([]thor.Address{acc1})
+	// more washed out than submitted
+	assert.InDelta(t, 0.25, b.score([]thor.Address{acc1}), 0.01)
+
+	stats := &senderStats{submitted: 4, washedOut: 2}
+	stats.decay(int64(scoreHalfLife))
+	assert.InDelta(t, 2.0, stats.submitted, 1e-9)
+	assert.InDelta(t, 1.0, stats.washedOut, 1e-9)
+}
+
+func TestSortByScore(t *testing.T) {
+	objs := []*txObject{{timeAdded: 1}, {timeAdded: 2}, {timeAdded: 3}}
+	scores := map[int64]float64{1: 0.5, 2: 1, 3: 0.5}
+	sortTxObjsByScoreDesc(objs, func(txObj *txObject) float64 {
+		return scores[txObj.timeAdded]
+	})
+	assert.Equal(t, []int64{2, 1, 3}, []int64{objs[0].timeAdded, objs[1].timeAdded, objs[2].timeAdded})
+}
+
+func TestSenderRateLimit(t *testing.T) {
+	db := muxdb.NewMem()
+	pool := New(newChainRepo(db), state.NewStater(db), Options{
+		Limit:           LIMIT,
+		LimitPerAccount: 3,
+		MaxLifetime:     time.Hour,
+		SenderRateLimit: 2,
+	})
+	defer pool.Close()
+
+	acc := genesis.DevAccounts()[0]
+	newTestTx := func() *tx.Transaction {
+		return newTx(pool.repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), acc)
+	}
+
+	assert.Nil(t, pool.Add(newTestTx()))
+	// txs added back are not counted
+	assert.Nil(t, pool.Restore(newTestTx()))
+	assert.Nil(t, pool.Add(newTestTx()))
+	err := pool.Add(newTestTx())
+	assert.Equal(t, ReasonRateLimited, RejectCode(err))
+
+	// limited separately, and rejected txs are not counted
+	err = pool.AddLocal(newTestTx())
+	assert.Equal(t, "tx rejected: account quota exceeded", err.Error())
+	assert.Nil(t, pool.localRateLimit.(*rateLimit).windows[acc.Address])
+}
@@ -10,6 +10,7 @@ import (
 	"sort"
 	"time"
 
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/pkg/errors"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/chain"
@@ -19,9 +20,18 @@ import (
 	"github.com/vechain/thor/tx"
 )
 
+var (
+	errKnownTx               = errors.New("known tx")
+	errGasTooLarge           = errors.New("gas too large")
+	errExpired               = errors.New("expired")
+	errBlockRefOutOfSchedule = errors.New("block ref out of schedule")
+	errDepReverted           = errors.New("dep reverted")
+)
+
 type txObject struct {
 	*tx.Transaction
 	resolved *runtime.ResolvedTransaction
+	key      thor.Bytes32 // txs with the same key differ only in gas price coef
 
 	timeAdded       int64
 	executable      bool
@@ -38,11 +48,32 @@ func resolveTx(tx *tx.Transaction, localSubmitted bool) (*txObject, error) {
 	return &txObject{
 		Transaction:    tx,
 		resolved:       resolved,
+		key:            replacementKey(tx, resolved),
 		timeAdded:      time.Now().UnixNano(),
 		localSubmitted: localSubmitted,
 	}, nil
 }
 
+// replacementKey computes the hash of all tx fields except gas price coef, together with origin and delegator.
+// A tx can be replaced by another one with the same key, which is signed by the same origin (and delegator).
+func replacementKey(tx *tx.Transaction, resolved *runtime.ResolvedTransaction) (key thor.Bytes32) {
+	hw := thor.NewBlake2b()
+	rlp.Encode(hw, []interface{}{
+		tx.ChainTag(),
+		tx.BlockRef(),
+		tx.Expiration(),
+		tx.Clauses(),
+		tx.Gas(),
+		tx.DependsOn(),
+		tx.Nonce(),
+		tx.Features(),
+		resolved.Origin,
+		resolved.Delegator,
+	})
+	hw.Sum(key[:0])
+	return
+}
+
 func (o *txObject) Origin() thor.Address {
 	return o.resolved.Origin
 }
@@ -50,12 +81,12 @@ func (o *txObject) Origin() thor.Address {
 func (o *txObject) Executable(chain *chain.Chain, state *state.State, headBlock *block.Header) (bool, error) {
 	switch {
 	case o.Gas() > headBlock.GasLimit():
-		return false, errors.New("gas too large")
+		return false, errGasTooLarge
 	case o.IsExpired(headBlock.Number()):
-		return false, errors.New("expired")
+		return false, errExpired
 	case o.BlockRef().Number() > headBlock.Number()+uint32(5*60/thor.BlockInterval):
 		// reject deferred tx which will be applied after 5mins
-		return false, errors.New("block ref out of schedule")
+		return false, errBlockRefOutOfSchedule
 	}
 
 	if _, err := chain.GetTransactionMeta(o.ID()); err != nil {
@@ -63,7 +94,7 @@ func (o *txObject) Executable(chain *chain.Chain, state *state.State, headBlock
 			return false, err
 		}
 	} else {
-		return false, errors.New("known tx")
+		return false, errKnownTx
 	}
 
 	if dep := o.DependsOn(); dep != nil {
@@ -75,7 +106,7 @@ func (o *txObject) Executable(chain *chain.Chain, state *state.State, headBlock
 			return false, err
 		}
 		if txMeta.Reverted {
-			return false, errors.New("dep reverted")
+			return false, errDepReverted
 		}
 	}
 
@@ -92,6 +123,17 @@ func (o *txObject) Executable(chain *chain.Chain, state *state.State, headBlock
 	return true, nil
 }
 
+// sortTxObjsByScoreDesc sorts tx objects by score of senders, while the original order is kept for equal scores.
+func sortTxObjsByScoreDesc(txObjs []*txObject, score func(txObj *txObject) float64) {
+	scores := make(map[*txObject]float64, len(txObjs))
+	for _, txObj := range txObjs {
+		scores[txObj] = score(txObj)
+	}
+	sort.SliceStable(txObjs, func(i, j int) bool {
+		return scores[txObjs[i]] > scores[txObjs[j]]
+	})
+}
+
 func sortTxObjsByOverallGasPriceDesc(txObjs []*txObject) {
 	sort.Slice(txObjs, func(i, j int) bool {
 		gp1, gp2 := txObjs[i].overallGasPrice, txObjs[j].overallGasPrice
@@ -18,6 +18,7 @@ type txObjectMap struct {
 	lock      sync.RWMutex
 	mapByHash map[thor.Bytes32]*txObject
 	mapByID   map[thor.Bytes32]*txObject
+	mapByKey  map[thor.Bytes32]*txObject
 	quota     map[thor.Address]int
 }
 
@@ -25,6 +26,7 @@ func newTxObjectMap() *txObjectMap {
 	return &txObjectMap{
 		mapByHash: make(map[thor.Bytes32]*txObject),
 		mapByID:   make(map[thor.Bytes32]*txObject),
+		mapByKey:  make(map[thor.Bytes32]*txObject),
 		quota:     make(map[thor.Address]int),
 	}
 }
@@ -36,23 +38,51 @@ func (m *txObjectMap) ContainsHash(txHash thor.Bytes32) bool {
 	return found
 }
 
-func (m *txObjectMap) Add(txObj *txObject, limitPerAccount int) error {
+// ContainsKey returns whether there's a tx object with the given replacement key.
+func (m *txObjectMap) ContainsKey(key thor.Bytes32) bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	_, found := m.mapByKey[key]
+	return found
+}
+
+// Add adds the tx object into the map.
+// If there's a tx object with the same replacement key, the new one takes its place only if
+// replaceable is given and returns no error, and the replaced tx object is returned.
+func (m *txObjectMap) Add(txObj *txObject, limitPerAccount int, replaceable func(old *txObject) error) (*txObject, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
 	hash := txObj.Hash()
 	if _, found := m.mapByHash[hash]; found {
-		return nil
+		return nil, nil
+	}
+
+	if old, found := m.mapByKey[txObj.key]; found {
+		if replaceable == nil {
+			return nil, errors.New("replacement not allowed")
+		}
+		if err := replaceable(old); err != nil {
+			return nil, err
+		}
+		// origin unchanged, so is the quota
+		delete(m.mapByHash, old.Hash())
+		delete(m.mapByID, old.ID())
+		m.mapByHash[hash] = txObj
+		m.mapByID[txObj.ID()] = txObj
+		m.mapByKey[txObj.key] = txObj
+		return old, nil
 	}
 
 	if m.quota[txObj.Origin()] >= limitPerAccount {
-		return errors.New("account quota exceeded")
+		return nil, errors.New("account quota exceeded")
 	}
 
 	m.quota[txObj.Origin()]++
 	m.mapByHash[hash] = txObj
 	m.mapByID[txObj.ID()] = txObj
-	return nil
+	m.mapByKey[txObj.key] = txObj
+	return nil, nil
 }
 
 func (m *txObjectMap) GetByID(id thor.Bytes32) *txObject {
@@ -73,6 +103,7 @@ func (m *txObjectMap) RemoveByHash(txHash thor.Bytes32) bool {
 		}
 		delete(m.mapByHash, txHash)
 		delete(m.mapByID, txObj.ID())
+		delete(m.mapByKey, txObj.key)
 		return true
 	}
 	return false
@@ -107,11 +138,15 @@ func (m *txObjectMap) Fill(txObjs []*txObject) {
 		if _, found := m.mapByHash[txObj.Hash()]; found {
 			continue
 		}
+		if _, found := m.mapByKey[txObj.key]; found {
+			continue
+		}
 		// skip account limit check
 
 		m.quota[txObj.Origin()]++
 		m.mapByHash[txObj.Hash()] = txObj
 		m.mapByID[txObj.ID()] = txObj
+		m.mapByKey[txObj.key] = txObj
 	}
 }
 
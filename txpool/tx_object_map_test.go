@@ -30,14 +30,18 @@ func TestTxObjMap(t *testing.T) {
 	m := newTxObjectMap()
 	assert.Zero(t, m.Len())
 
-	assert.Nil(t, m.Add(txObj1, 1))
-	assert.Nil(t, m.Add(txObj1, 1), "should no error if exists")
+	_, err := m.Add(txObj1, 1, nil)
+	assert.Nil(t, err)
+	_, err = m.Add(txObj1, 1, nil)
+	assert.Nil(t, err, "should no error if exists")
 	assert.Equal(t, 1, m.Len())
 
-	assert.Equal(t, errors.New("account quota exceeded"), m.Add(txObj2, 1))
+	_, err = m.Add(txObj2, 1, nil)
+	assert.Equal(t, errors.New("account quota exceeded"), err)
 	assert.Equal(t, 1, m.Len())
 
-	assert.Nil(t, m.Add(txObj3, 1))
+	_, err = m.Add(txObj3, 1, nil)
+	assert.Nil(t, err)
 	assert.Equal(t, 2, m.Len())
 
 	assert.True(t, m.ContainsHash(tx1.Hash()))
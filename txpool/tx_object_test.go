@@ -110,3 +110,35 @@ func TestExecutable(t *testing.T) {
 		}
 	}
 }
+
+func TestReplacementKey(t *testing.T) {
+	build := func(gasPriceCoef uint8, features tx.Features) *tx.Transaction {
+		return new(tx.Builder).
+			ChainTag(1).
+			Expiration(100).
+			Gas(21000).
+			Nonce(1).
+			GasPriceCoef(gasPriceCoef).
+			Features(features).
+			Build()
+	}
+	delegate := func(trx *tx.Transaction, acc genesis.DevAccount) *tx.Transaction {
+		origin := genesis.DevAccounts()[0]
+		sig, _ := crypto.Sign(trx.SigningHash().Bytes(), origin.PrivateKey)
+		dsig, _ := crypto.Sign(trx.DelegatorSigningHash(origin.Address).Bytes(), acc.PrivateKey)
+		return trx.WithSignature(append(sig, dsig...))
+	}
+
+	txObj1, _ := resolveTx(signTx(build(0, 0), genesis.DevAccounts()[0]), false)
+	txObj2, _ := resolveTx(signTx(build(100, 0), genesis.DevAccounts()[0]), false)
+	txObj3, _ := resolveTx(signTx(build(0, 0), genesis.DevAccounts()[1]), false)
+	assert.Equal(t, txObj1.key, txObj2.key)
+	assert.NotEqual(t, txObj1.key, txObj3.key, "different origin")
+
+	dtxObj1, _ := resolveTx(delegate(build(0, tx.DelegationFeature), genesis.DevAccounts()[1]), false)
+	dtxObj2, _ := resolveTx(delegate(build(100, tx.DelegationFeature), genesis.DevAccounts()[1]), false)
+	dtxObj3, _ := resolveTx(delegate(build(100, tx.DelegationFeature), genesis.DevAccounts()[2]), false)
+	assert.Equal(t, dtxObj1.key, dtxObj2.key)
+	assert.NotEqual(t, dtxObj1.key, dtxObj3.key, "different delegator")
+	assert.NotEqual(t, txObj1.key, dtxObj1.key)
+}
@@ -7,8 +7,10 @@ package txpool
 
 import (
 	"context"
+	"math/big"
 	"math/rand"
 	"os"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/mclock"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/inconshreveable/log15"
+	"github.com/pkg/errors"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/builtin"
 	"github.com/vechain/thor/chain"
@@ -39,6 +42,11 @@ type Options struct {
 	Limit                  int
 	LimitPerAccount        int
 	MaxLifetime            time.Duration
+	LocalMaxLifetime       time.Duration // lifetime of locally submitted txs, 0 means unlimited
+	PriceBump              int           // minimum overall gas price bump in percentage to replace a pending tx, see TxPool.replaceable
+	JournalPath            string        // file to persist txs across restarts, disabled if empty
+	Policy                 Policy        // admission policy, no extra restriction if nil
+	SenderRateLimit        int           // max txs per minute admitted from an origin or delegator, counted separately for local and relayed txs, 0 means unlimited
 	BlocklistCacheFilePath string
 	BlocklistFetchURL      string
 }
@@ -56,9 +64,15 @@ type TxPool struct {
 	stater    *state.Stater
 	blocklist blocklist
 
-	executables    atomic.Value
-	all            *txObjectMap
-	addedAfterWash uint32
+	executables     atomic.Value
+	executablesLock sync.Mutex // serializes updates of executables
+	all             *txObjectMap
+	dropped         *droppedTxs
+	localScores     *scoreboard
+	remoteScores    *scoreboard
+	localRateLimit  Policy
+	remoteRateLimit Policy
+	addedAfterWash  uint32
 
 	ctx    context.Context
 	cancel func()
@@ -72,12 +86,28 @@ type TxPool struct {
 func New(repo *chain.Repository, stater *state.Stater, options Options) *TxPool {
 	ctx, cancel := context.WithCancel(context.Background())
 	pool := &TxPool{
-		options: options,
-		repo:    repo,
-		stater:  stater,
-		all:     newTxObjectMap(),
-		ctx:     ctx,
-		cancel:  cancel,
+		options:      options,
+		repo:         repo,
+		stater:       stater,
+		all:          newTxObjectMap(),
+		dropped:      newDroppedTxs(maxDroppedTxs),
+		localScores:  newScoreboard(maxTrackedSenders),
+		remoteScores: newScoreboard(maxTrackedSenders),
+		ctx:          ctx,
+		cancel:       cancel,
+	}
+	if options.SenderRateLimit > 0 {
+		pool.localRateLimit = SenderRateLimit(options.SenderRateLimit, time.Minute)
+		pool.remoteRateLimit = SenderRateLimit(options.SenderRateLimit, time.Minute)
+	}
+
+	if options.JournalPath != "" {
+		if n, err := pool.loadJournal(); err != nil {
+			log.Warn("failed to load journal", "err", err)
+		} else {
+			log.Debug("journal loaded", "count", n)
+		}
+		pool.goes.Go(pool.journalLoop)
 	}
 
 	pool.goes.Go(pool.housekeeping)
@@ -131,7 +161,7 @@ func (p *TxPool) housekeeping() {
 				if err != nil {
 					ctx = append(ctx, "err", err)
 				} else {
-					p.executables.Store(executables)
+					p.storeExecutables(executables)
 				}
 
 				log.Debug("wash done", ctx...)
@@ -200,12 +230,21 @@ func (p *TxPool) Close() {
 	log.Debug("closed")
 }
 
-//SubscribeTxEvent receivers will receive a tx
+// SubscribeTxEvent receivers will receive a tx
 func (p *TxPool) SubscribeTxEvent(ch chan *TxEvent) event.Subscription {
 	return p.scope.Track(p.txFeed.Subscribe(ch))
 }
 
-func (p *TxPool) add(newTx *tx.Transaction, rejectNonexecutable bool, localSubmitted bool) error {
+// add adds the tx into the pool. The tx is counted as a submission of its senders unless readded,
+// which means it was in the pool or packed before, e.g. txs from orphaned blocks or the journal.
+func (p *TxPool) add(newTx *tx.Transaction, rejectNonexecutable bool, localSubmitted bool, readded bool) (err error) {
+	defer func() {
+		// only rejections of local txs are recorded, otherwise relayed txs would flood the store of dropped txs
+		if localSubmitted && IsTxRejected(err) {
+			p.dropped.add(newTx.ID(), err.(txRejectedError).msg)
+		}
+	}()
+
 	if p.all.ContainsHash(newTx.Hash()) {
 		// tx already in the pool
 		return nil
@@ -224,11 +263,11 @@ func (p *TxPool) add(newTx *tx.Transaction, rejectNonexecutable bool, localSubmi
 	case newTx.ChainTag() != p.repo.ChainTag():
 		return badTxError{"chain tag mismatch"}
 	case newTx.Size() > maxTxSize:
-		return txRejectedError{"size too large"}
+		return txRejectedError{msg: "size too large"}
 	}
 
 	if err := newTx.TestFeatures(headBlock.TxsFeatures()); err != nil {
-		return txRejectedError{err.Error()}
+		return txRejectedError{msg: err.Error()}
 	}
 
 	txObj, err := resolveTx(newTx, localSubmitted)
@@ -236,22 +275,44 @@ func (p *TxPool) add(newTx *tx.Transaction, rejectNonexecutable bool, localSubmi
 		return badTxError{err.Error()}
 	}
 
+	policy := p.admissionPolicy(localSubmitted)
+	if err := policy.Admit(newTx, txObj.Origin(), txObj.resolved.Delegator); err != nil {
+		return policyRejected(err)
+	}
+	defer func() {
+		// counted by Admit, which is undone if not added or readded
+		if err != nil || readded {
+			policy.Release(newTx, txObj.Origin(), txObj.resolved.Delegator)
+		}
+	}()
+
+	// the price to replace a pending tx is computed only if there's one
+	var replaceable func(old *txObject) error
+	if p.all.ContainsKey(txObj.key) {
+		if replaceable, err = p.replaceable(txObj, headBlock); err != nil {
+			return txRejectedError{msg: err.Error()}
+		}
+	}
+
 	if isChainSynced(uint64(time.Now().Unix()), headBlock.Timestamp()) {
 		state := p.stater.NewState(headBlock.StateRoot())
 		executable, err := txObj.Executable(p.repo.NewChain(headBlock.ID()), state, headBlock)
 		if err != nil {
-			return txRejectedError{err.Error()}
+			return txRejectedError{msg: err.Error()}
 		}
 
 		if rejectNonexecutable && !executable {
-			return txRejectedError{"tx is not executable"}
+			return txRejectedError{msg: "tx is not executable"}
 		}
 
-		if err := p.all.Add(txObj, p.options.LimitPerAccount); err != nil {
-			return txRejectedError{err.Error()}
+		replaced, err := p.all.Add(txObj, p.options.LimitPerAccount, replaceable)
+		if err != nil {
+			return txRejectedError{msg: err.Error()}
 		}
+		p.onReplaced(replaced, newTx)
 
 		txObj.executable = executable
+		p.dropped.remove(newTx.ID())
 		p.goes.Go(func() {
 			p.txFeed.Send(&TxEvent{newTx, &executable})
 		})
@@ -260,28 +321,132 @@ func (p *TxPool) add(newTx *tx.Transaction, rejectNonexecutable bool, localSubmi
 		// we skip steps that rely on head block when chain is not synced,
 		// but check the pool's limit
 		if p.all.Len() >= p.options.Limit {
-			return txRejectedError{"pool is full"}
+			return txRejectedError{msg: "pool is full"}
 		}
 
-		if err := p.all.Add(txObj, p.options.LimitPerAccount); err != nil {
-			return txRejectedError{err.Error()}
+		replaced, err := p.all.Add(txObj, p.options.LimitPerAccount, replaceable)
+		if err != nil {
+			return txRejectedError{msg: err.Error()}
 		}
+		p.onReplaced(replaced, newTx)
+		p.dropped.remove(newTx.ID())
 		log.Debug("tx added", "id", newTx.ID())
 		p.txFeed.Send(&TxEvent{newTx, nil})
 	}
+	if !readded {
+		p.scoreboard(localSubmitted).submit(txObj.senders())
+	}
 	atomic.AddUint32(&p.addedAfterWash, 1)
 	return nil
 }
 
+// replaceable returns the func to check whether a pending tx can be replaced by txObj.
+// The overall gas price of txObj should be higher than the pending one by at least PriceBump percent.
+// The price of txObj is computed ahead, since the func is called under the lock of the tx object map.
+//
+// Replacement only takes effect in this pool. Thor has no account nonce, so the replaced tx is still valid
+// on chain, and can be packed by other nodes which still have it, along with the replacement.
+func (p *TxPool) replaceable(txObj *txObject, headBlock *block.Header) (func(old *txObject) error, error) {
+	state := p.stater.NewState(headBlock.StateRoot())
+	baseGasPrice, err := builtin.Params.Native(state).Get(thor.KeyBaseGasPrice)
+	if err != nil {
+		return nil, err
+	}
+	chain := p.repo.NewChain(headBlock.ID())
+
+	var refBlockID thor.Bytes32
+	newWork, err := txObj.ProvedWork(headBlock.Number(), func(num uint32) (id thor.Bytes32, err error) {
+		id, err = chain.GetBlockID(num)
+		refBlockID = id
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	newPrice := txObj.OverallGasPrice(baseGasPrice, newWork)
+
+	return func(old *txObject) error {
+		// both txs have the same block ref, so the ref block ID is reused
+		oldWork, err := old.ProvedWork(headBlock.Number(), func(uint32) (thor.Bytes32, error) {
+			return refBlockID, nil
+		})
+		if err != nil {
+			return err
+		}
+		oldPrice := old.OverallGasPrice(baseGasPrice, oldWork)
+
+		threshold := new(big.Int).Mul(oldPrice, big.NewInt(int64(100+p.options.PriceBump)))
+		threshold.Div(threshold, big.NewInt(100))
+		if newPrice.Cmp(oldPrice) <= 0 || newPrice.Cmp(threshold) < 0 {
+			return errors.New("replacement tx underpriced")
+		}
+		// the replacement is signed by the same origin, keep it local if the replaced one is
+		if old.localSubmitted {
+			txObj.localSubmitted = true
+		}
+		return nil
+	}, nil
+}
+
+// onReplaced records the replaced tx as dropped, and removes it from executables at once,
+// so that it's not packed along with the replacement by this node.
+func (p *TxPool) onReplaced(replaced *txObject, newTx *tx.Transaction) {
+	if replaced == nil {
+		return
+	}
+	p.removeExecutable(replaced.Hash())
+	p.dropped.add(replaced.ID(), "replaced by "+newTx.ID().String())
+	log.Debug("tx replaced", "id", replaced.ID(), "by", newTx.ID())
+}
+
+// storeExecutables updates executables with the result of washing.
+// Txs no longer in the pool, e.g. replaced while washing, are excluded.
+func (p *TxPool) storeExecutables(executables tx.Transactions) {
+	p.executablesLock.Lock()
+	defer p.executablesLock.Unlock()
+
+	filtered := make(tx.Transactions, 0, len(executables))
+	for _, tx := range executables {
+		if p.all.ContainsHash(tx.Hash()) {
+			filtered = append(filtered, tx)
+		}
+	}
+	p.executables.Store(filtered)
+}
+
+// removeExecutable removes the tx from executables, which are otherwise updated on the next washing.
+func (p *TxPool) removeExecutable(txHash thor.Bytes32) {
+	p.executablesLock.Lock()
+	defer p.executablesLock.Unlock()
+
+	executables := p.Executables()
+	for i, trx := range executables {
+		if trx.Hash() == txHash {
+			// copy on write, since the old one may be in use
+			updated := make(tx.Transactions, 0, len(executables)-1)
+			updated = append(updated, executables[:i]...)
+			updated = append(updated, executables[i+1:]...)
+			p.executables.Store(updated)
+			return
+		}
+	}
+}
+
 // Add add new tx into pool.
 // It's not assumed as an error if the tx to be added is already in the pool,
 func (p *TxPool) Add(newTx *tx.Transaction) error {
-	return p.add(newTx, false, false)
+	return p.add(newTx, false, false, false)
 }
 
 // AddLocal adds new locally submitted tx into pool.
 func (p *TxPool) AddLocal(newTx *tx.Transaction) error {
-	return p.add(newTx, false, true)
+	return p.add(newTx, false, true, false)
+}
+
+// Restore adds back the tx of an orphaned block into pool.
+// Unlike Add, it's not counted as a submission of the tx senders.
+func (p *TxPool) Restore(newTx *tx.Transaction) error {
+	return p.add(newTx, false, false, true)
 }
 
 // Get get pooled tx by id.
@@ -294,18 +459,89 @@ func (p *TxPool) Get(id thor.Bytes32) *tx.Transaction {
 
 // StrictlyAdd add new tx into pool. A rejection error will be returned, if tx is not executable at this time.
 func (p *TxPool) StrictlyAdd(newTx *tx.Transaction) error {
-	return p.add(newTx, true, false)
+	return p.add(newTx, true, false, false)
 }
 
 // Remove removes tx from pool by its Hash.
+// It's called when the tx is not adoptable by packer, and the tx is recorded as dropped.
 func (p *TxPool) Remove(txHash thor.Bytes32, txID thor.Bytes32) bool {
+	return p.remove(txHash, txID, "not adoptable")
+}
+
+func (p *TxPool) remove(txHash thor.Bytes32, txID thor.Bytes32, reason string) bool {
 	if p.all.RemoveByHash(txHash) {
-		log.Debug("tx removed", "id", txID)
+		p.dropped.add(txID, reason)
+		log.Debug("tx removed", "id", txID, "reason", reason)
 		return true
 	}
 	return false
 }
 
+// isOutOfLifetime returns whether the tx added at timeAdded is out of lifetime.
+// Local txs have their own lifetime, which is unlimited if not set.
+func (p *TxPool) isOutOfLifetime(localSubmitted bool, timeAdded int64, now int64) bool {
+	if localSubmitted {
+		return p.options.LocalMaxLifetime > 0 && now > timeAdded+int64(p.options.LocalMaxLifetime)
+	}
+	return now > timeAdded+int64(p.options.MaxLifetime)
+}
+
+// admissionPolicy returns the policy to admit local or relayed txs, including the sender rate limit of each side.
+func (p *TxPool) admissionPolicy(local bool) Policies {
+	var policies Policies
+	if p.options.Policy != nil {
+		policies = append(policies, p.options.Policy)
+	}
+	if local && p.localRateLimit != nil {
+		policies = append(policies, p.localRateLimit)
+	} else if !local && p.remoteRateLimit != nil {
+		policies = append(policies, p.remoteRateLimit)
+	}
+	return policies
+}
+
+// scoreboard returns the scoreboard to track senders of local or relayed txs.
+func (p *TxPool) scoreboard(local bool) *scoreboard {
+	if local {
+		return p.localScores
+	}
+	return p.remoteScores
+}
+
+// GetDropped returns why and when the tx was dropped, if it's recently evicted or rejected by the pool.
+func (p *TxPool) GetDropped(txID thor.Bytes32) *DropInfo {
+	return p.dropped.get(txID)
+}
+
+// IsExecutable returns whether the tx in the pool is executable, according to the last evaluation by the pool.
+func (p *TxPool) IsExecutable(txID thor.Bytes32) bool {
+	for _, tx := range p.Executables() {
+		if tx.ID() == txID {
+			return true
+		}
+	}
+	return false
+}
+
+// TimeAdded returns the time when the tx was added into the pool, or zero time if not in the pool.
+func (p *TxPool) TimeAdded(txID thor.Bytes32) time.Time {
+	if txObj := p.all.GetByID(txID); txObj != nil {
+		return time.Unix(0, txObj.timeAdded)
+	}
+	return time.Time{}
+}
+
+// LocalExecutables returns executable txs which are submitted locally.
+func (p *TxPool) LocalExecutables() tx.Transactions {
+	var txs tx.Transactions
+	for _, tx := range p.Executables() {
+		if txObj := p.all.GetByID(tx.ID()); txObj != nil && txObj.localSubmitted {
+			txs = append(txs, tx)
+		}
+	}
+	return txs
+}
+
 // Executables returns executable txs.
 func (p *TxPool) Executables() tx.Transactions {
 	if sorted := p.executables.Load(); sorted != nil {
@@ -314,6 +550,11 @@ func (p *TxPool) Executables() tx.Transactions {
 	return nil
 }
 
+// Len returns count of all txs in the pool.
+func (p *TxPool) Len() int {
+	return p.all.Len()
+}
+
 // Fill fills txs into pool.
 func (p *TxPool) Fill(txs tx.Transactions, localSubmitted bool) {
 	txObjs := make([]*txObject, 0, len(txs))
@@ -339,7 +580,15 @@ func (p *TxPool) Dump() tx.Transactions {
 // this method should only be called in housekeeping go routine
 func (p *TxPool) wash(headBlock *block.Header) (executables tx.Transactions, removed int, err error) {
 	all := p.all.ToTxObjects()
-	var toRemove []*txObject
+	var (
+		toRemove      []*txObject
+		removeReasons []string
+	)
+	// the tx is not recorded as dropped if reason is empty
+	remove := func(txObj *txObject, reason string) {
+		toRemove = append(toRemove, txObj)
+		removeReasons = append(removeReasons, reason)
+	}
 	defer func() {
 		if err != nil {
 			// in case of error, simply cut pool size to limit
@@ -349,10 +598,14 @@ func (p *TxPool) wash(headBlock *block.Header) (executables tx.Transactions, rem
 				}
 				removed++
 				p.all.RemoveByHash(txObj.Hash())
+				p.dropped.add(txObj.ID(), "pool limit exceeded")
 			}
 		} else {
-			for _, txObj := range toRemove {
+			for i, txObj := range toRemove {
 				p.all.RemoveByHash(txObj.Hash())
+				if reason := removeReasons[i]; reason != "" {
+					p.dropped.add(txObj.ID(), reason)
+				}
 			}
 			removed = len(toRemove)
 		}
@@ -374,21 +627,38 @@ func (p *TxPool) wash(headBlock *block.Header) (executables tx.Transactions, rem
 	)
 	for _, txObj := range all {
 		if thor.IsOriginBlocked(txObj.Origin()) || p.blocklist.Contains(txObj.Origin()) {
-			toRemove = append(toRemove, txObj)
+			remove(txObj, "blocked")
 			log.Debug("tx washed out", "id", txObj.ID(), "err", "blocked")
 			continue
 		}
 
+		if p.options.Policy != nil {
+			if err := p.options.Policy.Retain(txObj.Transaction, txObj.Origin(), txObj.resolved.Delegator); err != nil {
+				remove(txObj, err.Error())
+				log.Debug("tx washed out", "id", txObj.ID(), "err", err)
+				continue
+			}
+		}
+
 		// out of lifetime
-		if !txObj.localSubmitted && now > txObj.timeAdded+int64(p.options.MaxLifetime) {
-			toRemove = append(toRemove, txObj)
+		if p.isOutOfLifetime(txObj.localSubmitted, txObj.timeAdded, now) {
+			if !txObj.executable {
+				p.scoreboard(txObj.localSubmitted).washOut(txObj.senders())
+			}
+			remove(txObj, "out of lifetime")
 			log.Debug("tx washed out", "id", txObj.ID(), "err", "out of lifetime")
 			continue
 		}
 		// settled, out of energy or dep broken
 		executable, err := txObj.Executable(chain, state, headBlock)
 		if err != nil {
-			toRemove = append(toRemove, txObj)
+			if err == errKnownTx {
+				// packed, not dropped
+				remove(txObj, "")
+			} else {
+				p.scoreboard(txObj.localSubmitted).washOut(txObj.senders())
+				remove(txObj, err.Error())
+			}
 			log.Debug("tx washed out", "id", txObj.ID(), "err", err)
 			continue
 		}
@@ -396,7 +666,7 @@ func (p *TxPool) wash(headBlock *block.Header) (executables tx.Transactions, rem
 		if executable {
 			provedWork, err := txObj.ProvedWork(headBlock.Number(), chain.GetBlockID)
 			if err != nil {
-				toRemove = append(toRemove, txObj)
+				remove(txObj, err.Error())
 				log.Debug("tx washed out", "id", txObj.ID(), "err", err)
 				continue
 			}
@@ -417,24 +687,28 @@ func (p *TxPool) wash(headBlock *block.Header) (executables tx.Transactions, rem
 
 	// sort objs by price from high to low.
 	sortTxObjsByOverallGasPriceDesc(executableObjs)
+	// sort non-executable objs by score from high to low, so that txs of low scored senders are evicted first.
+	sortTxObjsByScoreDesc(nonExecutableObjs, func(txObj *txObject) float64 {
+		return p.remoteScores.score(txObj.senders())
+	})
 
 	limit := p.options.Limit
 
 	// remove over limit txs, from non-executables to low priced
 	if len(executableObjs) > limit {
 		for _, txObj := range nonExecutableObjs {
-			toRemove = append(toRemove, txObj)
+			remove(txObj, "pool limit exceeded")
 			log.Debug("non-executable tx washed out due to pool limit", "id", txObj.ID())
 		}
 		for _, txObj := range executableObjs[limit:] {
-			toRemove = append(toRemove, txObj)
+			remove(txObj, "pool limit exceeded")
 			log.Debug("executable tx washed out due to pool limit", "id", txObj.ID())
 		}
 		executableObjs = executableObjs[:limit]
 	} else if len(executableObjs)+len(nonExecutableObjs) > limit {
 		// executableObjs + nonExecutableObjs over pool limit
 		for _, txObj := range nonExecutableObjs[limit-len(executableObjs):] {
-			toRemove = append(toRemove, txObj)
+			remove(txObj, "pool limit exceeded")
 			log.Debug("non-executable tx washed out due to pool limit", "id", txObj.ID())
 		}
 	}
@@ -447,9 +721,10 @@ func (p *TxPool) wash(headBlock *block.Header) (executables tx.Transactions, rem
 	executables = make(tx.Transactions, 0, len(executableObjs))
 	var toBroadcast tx.Transactions
 
+	// only txs becoming executable are broadcast, local ones are re-announced by comm periodically
 	for _, obj := range executableObjs {
 		executables = append(executables, obj.Transaction)
-		if !obj.executable || obj.localSubmitted {
+		if !obj.executable {
 			obj.executable = true
 			toBroadcast = append(toBroadcast, obj.Transaction)
 		}
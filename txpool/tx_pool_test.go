@@ -99,11 +99,13 @@ func TestWashTxs(t *testing.T) {
 
 	tx2 := newTx(pool.repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), genesis.DevAccounts()[1])
 	txObj2, _ := resolveTx(tx2, false)
-	assert.Nil(t, pool.all.Add(txObj2, LIMIT_PER_ACCOUNT)) // this tx will participate in the wash out.
+	_, err = pool.all.Add(txObj2, LIMIT_PER_ACCOUNT, nil)
+	assert.Nil(t, err) // this tx will participate in the wash out.
 
 	tx3 := newTx(pool.repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), genesis.DevAccounts()[2])
 	txObj3, _ := resolveTx(tx3, false)
-	assert.Nil(t, pool.all.Add(txObj3, LIMIT_PER_ACCOUNT)) // this tx will participate in the wash out.
+	_, err = pool.all.Add(txObj3, LIMIT_PER_ACCOUNT, nil)
+	assert.Nil(t, err) // this tx will participate in the wash out.
 
 	txs, removedCount, err := pool.wash(pool.repo.BestBlock().Header())
 	assert.Nil(t, err)
@@ -191,3 +193,164 @@ func TestBeforeVIP191Add(t *testing.T) {
 
 	assert.Equal(t, "tx rejected: unsupported features", err.Error())
 }
+
+func TestDropped(t *testing.T) {
+	pool := newPool(1, LIMIT_PER_ACCOUNT)
+	defer pool.Close()
+	b1 := new(block.Builder).
+		ParentID(pool.repo.GenesisBlock().Header().ID()).
+		Timestamp(uint64(time.Now().Unix())).
+		TotalScore(100).
+		GasLimit(10000000).
+		StateRoot(pool.repo.GenesisBlock().Header().StateRoot()).
+		Build()
+	pool.repo.AddBlock(b1, nil)
+	pool.repo.SetBestBlockID(b1.Header().ID())
+	acc := genesis.DevAccounts()[0]
+
+	tx1 := newTx(pool.repo.ChainTag(), nil, 21000, tx.NewBlockRef(10), 100, nil, tx.Features(0), acc)
+	assert.NotNil(t, pool.StrictlyAdd(tx1))
+	assert.Nil(t, pool.GetDropped(tx1.ID()), "rejection of relayed tx not recorded")
+
+	// local rejection is recorded
+	pool.options.Policy = MinGasPriceCoef(1)
+	assert.NotNil(t, pool.AddLocal(tx1))
+	assert.Equal(t, "gas price coef lower than 1", pool.GetDropped(tx1.ID()).Reason)
+	pool.options.Policy = nil
+
+	// re-added
+	assert.Nil(t, pool.Add(tx1))
+	assert.Nil(t, pool.GetDropped(tx1.ID()))
+	assert.False(t, pool.IsExecutable(tx1.ID()))
+
+	tx2 := newTx(pool.repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), genesis.DevAccounts()[1])
+	assert.Nil(t, pool.Add(tx2))
+
+	executables, _, err := pool.wash(pool.repo.BestBlock().Header())
+	assert.Nil(t, err)
+	pool.executables.Store(executables)
+	assert.True(t, pool.IsExecutable(tx2.ID()))
+	assert.Equal(t, "pool limit exceeded", pool.GetDropped(tx1.ID()).Reason)
+
+	assert.True(t, pool.Remove(tx2.Hash(), tx2.ID()))
+	assert.Equal(t, "not adoptable", pool.GetDropped(tx2.ID()).Reason)
+}
+
+func TestReplace(t *testing.T) {
+	pool := newPool(LIMIT, LIMIT_PER_ACCOUNT)
+	defer pool.Close()
+	pool.options.PriceBump = 10
+
+	txCh := make(chan *TxEvent, 10)
+	pool.SubscribeTxEvent(txCh)
+
+	acc := genesis.DevAccounts()[0]
+	build := func(gasPriceCoef uint8) *tx.Transaction {
+		return signTx(new(tx.Builder).
+			ChainTag(pool.repo.ChainTag()).
+			Expiration(100).
+			Gas(21000).
+			Nonce(1).
+			GasPriceCoef(gasPriceCoef).
+			Build(), acc)
+	}
+
+	tx1 := build(0)
+	assert.Nil(t, pool.AddLocal(tx1))
+	assert.Equal(t, tx1, (<-txCh).Tx)
+
+	// ~4% higher
+	tx2 := build(10)
+	err := pool.Add(tx2)
+	assert.True(t, IsTxRejected(err))
+	assert.Equal(t, "tx rejected: replacement tx underpriced", err.Error())
+	assert.NotNil(t, pool.Get(tx1.ID()))
+
+	executables, _, err := pool.wash(pool.repo.BestBlock().Header())
+	assert.Nil(t, err)
+	pool.executables.Store(executables)
+	assert.True(t, pool.IsExecutable(tx1.ID()))
+	assert.Equal(t, tx1, (<-txCh).Tx)
+
+	// doubled
+	tx3 := build(255)
+	assert.Nil(t, pool.Add(tx3))
+	assert.False(t, pool.IsExecutable(tx1.ID()), "replaced tx removed from executables at once")
+	assert.Equal(t, tx3, (<-txCh).Tx)
+	assert.Equal(t, 1, pool.all.Len())
+	assert.Nil(t, pool.Get(tx1.ID()))
+	assert.NotNil(t, pool.Get(tx3.ID()))
+	assert.True(t, pool.all.GetByID(tx3.ID()).localSubmitted)
+	assert.Equal(t, "replaced by "+tx3.ID().String(), pool.GetDropped(tx1.ID()).Reason)
+
+	// the replaced one can't come back
+	assert.NotNil(t, pool.Add(tx1))
+}
+
+func TestAddWithPolicy(t *testing.T) {
+	pool := newPool(LIMIT, LIMIT_PER_ACCOUNT)
+	defer pool.Close()
+	pool.options.Policy = AllowedOrigins([]thor.Address{genesis.DevAccounts()[0].Address})
+
+	tx1 := newTx(pool.repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), genesis.DevAccounts()[0])
+	assert.Nil(t, pool.Add(tx1))
+
+	tx2 := newTx(pool.repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), genesis.DevAccounts()[1])
+	err := pool.AddLocal(tx2)
+	assert.True(t, IsTxRejected(err))
+	assert.Equal(t, ReasonOriginNotAllowed, RejectCode(err))
+	assert.Equal(t, "origin not allowed", pool.GetDropped(tx2.ID()).Reason)
+
+	// policy changed
+	pool.options.Policy = AllowedOrigins(nil)
+	_, removed, err := pool.wash(pool.repo.BestBlock().Header())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, removed)
+	assert.Equal(t, "origin not allowed", pool.GetDropped(tx1.ID()).Reason)
+}
+
+func TestLocalLifetime(t *testing.T) {
+	pool := newPool(LIMIT, LIMIT_PER_ACCOUNT)
+	defer pool.Close()
+
+	tx1 := newTx(pool.repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), genesis.DevAccounts()[0])
+	tx2 := newTx(pool.repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), genesis.DevAccounts()[1])
+	assert.Nil(t, pool.AddLocal(tx1))
+	assert.Nil(t, pool.Add(tx2))
+
+	txCh := make(chan *TxEvent, 10)
+	pool.SubscribeTxEvent(txCh)
+
+	executables, _, err := pool.wash(pool.repo.BestBlock().Header())
+	assert.Nil(t, err)
+	pool.executables.Store(executables)
+	assert.Equal(t, Tx.Transactions{tx1}, pool.LocalExecutables())
+	<-txCh
+	<-txCh
+
+	// local txs are not broadcast again by washing
+	_, _, err = pool.wash(pool.repo.BestBlock().Header())
+	assert.Nil(t, err)
+	select {
+	case ev := <-txCh:
+		t.Errorf("unexpected tx event %v", ev.Tx.ID())
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// unlimited by default
+	pool.all.GetByID(tx1.ID()).timeAdded = time.Now().Add(-2 * time.Hour).UnixNano()
+	_, removed, err := pool.wash(pool.repo.BestBlock().Header())
+	assert.Nil(t, err)
+	assert.Zero(t, removed)
+
+	pool.options.LocalMaxLifetime = 3 * time.Hour
+	_, removed, err = pool.wash(pool.repo.BestBlock().Header())
+	assert.Nil(t, err)
+	assert.Zero(t, removed)
+
+	pool.options.LocalMaxLifetime = time.Hour
+	_, removed, err = pool.wash(pool.repo.BestBlock().Header())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, removed)
+	assert.Equal(t, "out of lifetime", pool.GetDropped(tx1.ID()).Reason)
+}